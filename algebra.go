@@ -0,0 +1,121 @@
+package verlib
+
+// Negate returns the ConstraintExpression matching every Version that does
+// not satisfy c. For the simple comparison operators the negation is a
+// single Constraint (e.g. the negation of `>= 1.2.3` is `< 1.2.3`), but the
+// negation of `~> 1.2.0` is `< 1.2.0 || >= 1.3.0`, which can't be expressed
+// as a single Constraint - hence the ConstraintExpression return type.
+func (c Constraint) Negate() ConstraintExpression {
+	switch c.operator {
+	case EQ:
+		return ConstraintExpression{{Constraint{operator: NE, version: c.version}}}
+	case NE:
+		return ConstraintExpression{{Constraint{operator: EQ, version: c.version}}}
+	case GT:
+		return ConstraintExpression{{Constraint{operator: LE, version: c.version}}}
+	case GE:
+		return ConstraintExpression{{Constraint{operator: LT, version: c.version}}}
+	case LT:
+		return ConstraintExpression{{Constraint{operator: GE, version: c.version}}}
+	case LE:
+		return ConstraintExpression{{Constraint{operator: GT, version: c.version}}}
+	case GEPessimistic:
+		return ConstraintExpression{
+			{Constraint{operator: LT, version: c.version}},
+			{Constraint{operator: GE, version: c.version.IncrementPessimistic()}},
+		}
+	case Caret:
+		return ConstraintExpression{
+			{Constraint{operator: LT, version: c.version}},
+			{Constraint{operator: GE, version: c.version.IncrementCaret()}},
+		}
+	case Tilde:
+		return ConstraintExpression{
+			{Constraint{operator: LT, version: c.version}},
+			{Constraint{operator: GE, version: c.version.IncrementTilde()}},
+		}
+	default:
+		return nil
+	}
+}
+
+// Negate returns the ConstraintExpression matching every Version that does
+// not satisfy every constraint in c, per De Morgan's law: the negation of
+// an AND-group is the OR of the negations of its members.
+func (c Constraints) Negate() ConstraintExpression {
+	var result ConstraintExpression
+	for _, constraint := range c {
+		result = append(result, constraint.Negate()...)
+	}
+	return result
+}
+
+// Intersect returns the AND-group combining every constraint in c with
+// every constraint in other. The result is not automatically reduced; call
+// Simplify on it to collapse redundant bounds.
+func (c Constraints) Intersect(other Constraints) Constraints {
+	result := make(Constraints, 0, len(c)+len(other))
+	result = append(result, c...)
+	result = append(result, other...)
+	return result
+}
+
+// IntersectExpression distributes c over expr, returning the
+// ConstraintExpression equivalent to "c AND expr": (c AND alt1) OR (c AND
+// alt2) OR ... This is what lets Constraints reason about a negated
+// ConstraintExpression, since Negate on Constraints produces one.
+func (c Constraints) IntersectExpression(expr ConstraintExpression) ConstraintExpression {
+	result := make(ConstraintExpression, 0, len(expr))
+	for _, alternative := range expr {
+		result = append(result, c.Intersect(alternative))
+	}
+	return result
+}
+
+// Union returns the ConstraintExpression matching every Version that
+// satisfies c or other. Unlike Intersect, the union of two AND-groups is
+// not generally expressible as another flat AND-group, so Union returns a
+// ConstraintExpression rather than Constraints.
+func (c Constraints) Union(other Constraints) ConstraintExpression {
+	return ConstraintExpression{c, other}
+}
+
+// IsEmpty reports whether no Version can satisfy every constraint in c,
+// i.e. whether c is unsatisfiable. It is implemented in terms of Simplify.
+func (c Constraints) IsEmpty() bool {
+	_, err := c.Simplify()
+	return err != nil
+}
+
+// IsEmpty reports whether no Version satisfies any alternative of ce, i.e.
+// whether the expression as a whole is unsatisfiable.
+func (ce ConstraintExpression) IsEmpty() bool {
+	for _, alternative := range ce {
+		if !alternative.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSatisfiable reports whether at least one Version satisfies every
+// constraint in c. It is the positive-phrased complement of IsEmpty,
+// provided for callers such as dependency resolvers that find an
+// affirmative check more natural at the call site.
+func (c Constraints) IsSatisfiable() bool {
+	return !c.IsEmpty()
+}
+
+// IsSatisfiable reports whether at least one Version satisfies at least
+// one alternative of ce. It is the positive-phrased complement of IsEmpty.
+func (ce ConstraintExpression) IsSatisfiable() bool {
+	return !ce.IsEmpty()
+}
+
+// Implies reports whether every Version satisfying c also satisfies other,
+// i.e. whether c is at least as restrictive as other. It is computed as
+// c.IntersectExpression(other.Negate()).IsEmpty(): if no version can satisfy c while
+// violating other, c implies other.
+func (c Constraints) Implies(other Constraints) bool {
+	return c.IntersectExpression(other.Negate()).IsEmpty()
+}
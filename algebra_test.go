@@ -0,0 +1,96 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestConstraintNegate(t *testing.T) {
+	ge := verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 2, 3))
+	negated := ge.Negate()
+
+	if negated.Satisfies(verlib.NewVersion(1, 2, 3)) {
+		t.Error("expected negation of >= 1.2.3 to exclude 1.2.3")
+	}
+	if !negated.Satisfies(verlib.NewVersion(1, 0, 0)) {
+		t.Error("expected negation of >= 1.2.3 to include 1.0.0")
+	}
+}
+
+func TestConstraintNegatePessimistic(t *testing.T) {
+	pessimistic := verlib.NewConstraint(verlib.GEPessimistic, verlib.NewVersion(1, 2, 0))
+	negated := pessimistic.Negate()
+
+	if negated.Satisfies(verlib.NewVersion(1, 2, 5)) {
+		t.Error("expected negation of ~> 1.2.0 to exclude 1.2.5")
+	}
+	if !negated.Satisfies(verlib.NewVersion(1, 1, 0)) {
+		t.Error("expected negation of ~> 1.2.0 to include 1.1.0")
+	}
+	if !negated.Satisfies(verlib.NewVersion(1, 3, 0)) {
+		t.Error("expected negation of ~> 1.2.0 to include 1.3.0")
+	}
+}
+
+func TestConstraintsIsEmpty(t *testing.T) {
+	satisfiable := verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0))}
+	if satisfiable.IsEmpty() {
+		t.Error("expected >= 1.0.0 to be satisfiable")
+	}
+
+	unsatisfiable := verlib.Constraints{
+		verlib.NewConstraint(verlib.GE, verlib.NewVersion(2, 0, 0)),
+		verlib.NewConstraint(verlib.LT, verlib.NewVersion(1, 0, 0)),
+	}
+	if !unsatisfiable.IsEmpty() {
+		t.Error("expected >= 2.0.0, < 1.0.0 to be unsatisfiable")
+	}
+}
+
+func TestConstraintsIsSatisfiable(t *testing.T) {
+	satisfiable := verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0))}
+	if !satisfiable.IsSatisfiable() {
+		t.Error("expected >= 1.0.0 to be satisfiable")
+	}
+
+	unsatisfiable := verlib.Constraints{
+		verlib.NewConstraint(verlib.GE, verlib.NewVersion(2, 0, 0)),
+		verlib.NewConstraint(verlib.LT, verlib.NewVersion(1, 0, 0)),
+	}
+	if unsatisfiable.IsSatisfiable() {
+		t.Error("expected >= 2.0.0, < 1.0.0 to not be satisfiable")
+	}
+}
+
+func TestConstraintExpressionIsSatisfiable(t *testing.T) {
+	ce := verlib.ConstraintExpression{
+		verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(2, 0, 0))},
+		verlib.Constraints{verlib.NewConstraint(verlib.LT, verlib.NewVersion(1, 0, 0))},
+	}
+	if !ce.IsSatisfiable() {
+		t.Error("expected >= 2.0.0 || < 1.0.0 to be satisfiable")
+	}
+
+	unsatisfiable := verlib.ConstraintExpression{
+		verlib.Constraints{
+			verlib.NewConstraint(verlib.GE, verlib.NewVersion(2, 0, 0)),
+			verlib.NewConstraint(verlib.LT, verlib.NewVersion(1, 0, 0)),
+		},
+	}
+	if unsatisfiable.IsSatisfiable() {
+		t.Error("expected the single contradictory alternative to not be satisfiable")
+	}
+}
+
+func TestConstraintsImplies(t *testing.T) {
+	tight := verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 5, 0))}
+	loose := verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0))}
+
+	if !tight.Implies(loose) {
+		t.Error("expected >= 1.5.0 to imply >= 1.0.0")
+	}
+	if loose.Implies(tight) {
+		t.Error("expected >= 1.0.0 to not imply >= 1.5.0")
+	}
+}
@@ -0,0 +1,353 @@
+package verlib
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CalVer represents a calendar-based version, the scheme used by projects
+// that prefer a release's date over semantic increments (Ubuntu, JetBrains,
+// pip). It recognizes "YYYY.MM.DD", "YY.MM", and zero-padded variants like
+// "YYYY.0M.0D", plus a "YYYY.MM.MICRO" form where the trailing component is
+// a release counter rather than a day. An optional pre-release and build
+// metadata suffix follow the same grammar as SemVer.
+type CalVer struct {
+	yearWidth     int     // 2 or 4: the number of digits the year is formatted with
+	year          int
+	month         int
+	monthZeroPad  bool
+	hasDay        bool
+	day           int
+	dayZeroPad    bool
+	micro         *uint64 // set instead of day for YYYY.MM.MICRO-style schemes
+	preRelease    string
+	buildMetadata string
+}
+
+// NewCalVer creates a CalVer for the date t, formatted according to layout,
+// a time.Format-compatible layout such as "2006.01.02" (zero-padded
+// YYYY.MM.DD), "2006.1.2" (unpadded), or "06.01" (two-digit year, no day).
+func NewCalVer(layout string, t time.Time) CalVer {
+	c, err := parseCalVerLayout(layout)
+	if err != nil {
+		panic(fmt.Errorf("invalid CalVer layout %q: %w", layout, err))
+	}
+	c.year = t.Year()
+	c.month = int(t.Month())
+	if c.hasDay {
+		c.day = t.Day()
+	}
+	return c
+}
+
+// NewCalVerMicro is like NewCalVer, but attaches a micro counter instead of
+// a day component, for schemes such as "YYYY.MM.MICRO" (e.g. a "20.04.1"
+// point release) where the trailing component is a release counter.
+func NewCalVerMicro(layout string, t time.Time, micro uint64) CalVer {
+	c := NewCalVer(layout, t)
+	c.hasDay = false
+	c.micro = &micro
+	return c
+}
+
+// parseCalVerLayout interprets a time.Format-compatible layout string into
+// the width/padding flags CalVer tracks. It supports "2006"/"06" for the
+// year and "01"/"1" for the month, optionally followed by "02"/"2" for the
+// day, each dot-separated.
+func parseCalVerLayout(layout string) (CalVer, error) {
+	tokens := strings.Split(layout, ".")
+	if len(tokens) < 2 || len(tokens) > 3 {
+		return CalVer{}, fmt.Errorf("layout must have 2 or 3 dot-separated components, got %q", layout)
+	}
+
+	var c CalVer
+	switch tokens[0] {
+	case "2006":
+		c.yearWidth = 4
+	case "06":
+		c.yearWidth = 2
+	default:
+		return CalVer{}, fmt.Errorf("unrecognized year token %q", tokens[0])
+	}
+
+	switch tokens[1] {
+	case "01":
+		c.monthZeroPad = true
+	case "1":
+		c.monthZeroPad = false
+	default:
+		return CalVer{}, fmt.Errorf("unrecognized month token %q", tokens[1])
+	}
+
+	if len(tokens) == 3 {
+		c.hasDay = true
+		switch tokens[2] {
+		case "02":
+			c.dayZeroPad = true
+		case "2":
+			c.dayZeroPad = false
+		default:
+			return CalVer{}, fmt.Errorf("unrecognized day token %q", tokens[2])
+		}
+	}
+
+	return c, nil
+}
+
+// ParseCalVer parses a calendar-based version string, inferring its layout
+// from the shape of the input: a 2-digit first component is taken as a
+// two-digit year, a 4-digit first component as a four-digit year; a third
+// numeric component of at most two digits and no more than 31 is taken as a
+// day, and anything else is taken as a micro/release counter. An optional "-prerelease" and
+// "+buildmetadata" suffix follow the same grammar as SemVer.
+func ParseCalVer(s string) (CalVer, error) {
+	original := s
+
+	var buildMetadata string
+	if idx := strings.Index(s, "+"); idx >= 0 {
+		buildMetadata = s[idx+1:]
+		s = s[:idx]
+	}
+
+	var preRelease string
+	if idx := strings.Index(s, "-"); idx >= 0 {
+		preRelease = s[idx+1:]
+		s = s[:idx]
+	}
+
+	rawComponents := strings.Split(s, ".")
+	if len(rawComponents) < 2 || len(rawComponents) > 3 {
+		return CalVer{}, fmt.Errorf("calver %q must have 2 or 3 dot-separated components", original)
+	}
+
+	yearRaw := rawComponents[0]
+	switch len(yearRaw) {
+	case 2:
+		// two-digit year, handled below
+	case 4:
+		// four-digit year, handled below
+	default:
+		return CalVer{}, fmt.Errorf("calver %q has an unrecognized year component %q", original, yearRaw)
+	}
+	year, err := strconv.Atoi(yearRaw)
+	if err != nil {
+		return CalVer{}, fmt.Errorf("failed to parse year %q in %q: %w", yearRaw, original, err)
+	}
+
+	monthRaw := rawComponents[1]
+	month, err := strconv.Atoi(monthRaw)
+	if err != nil {
+		return CalVer{}, fmt.Errorf("failed to parse month %q in %q: %w", monthRaw, original, err)
+	}
+
+	c := CalVer{
+		yearWidth:     len(yearRaw),
+		year:          year,
+		month:         month,
+		monthZeroPad:  len(monthRaw) > 1 && monthRaw[0] == '0',
+		preRelease:    preRelease,
+		buildMetadata: buildMetadata,
+	}
+
+	if len(rawComponents) == 3 {
+		thirdRaw := rawComponents[2]
+		third, err := strconv.Atoi(thirdRaw)
+		if err != nil {
+			return CalVer{}, fmt.Errorf("failed to parse %q in %q: %w", thirdRaw, original, err)
+		}
+
+		if third <= 31 && len(thirdRaw) <= 2 {
+			c.hasDay = true
+			c.day = third
+			c.dayZeroPad = len(thirdRaw) > 1 && thirdRaw[0] == '0'
+		} else {
+			micro := uint64(third)
+			c.micro = &micro
+		}
+	}
+
+	return c, nil
+}
+
+// MustParseCalVer is similar to ParseCalVer, but it panics if the parsing
+// fails. It's useful when you're certain the input version string is
+// valid, and any failure is a programming error that should stop the
+// program execution.
+func MustParseCalVer(s string) CalVer {
+	c, err := ParseCalVer(s)
+	if err != nil {
+		panic(fmt.Errorf("failed to parse calver: %w", err))
+	}
+	return c
+}
+
+func (c CalVer) formatYear() string {
+	if c.yearWidth == 2 {
+		return fmt.Sprintf("%02d", c.year%100)
+	}
+	return strconv.Itoa(c.year)
+}
+
+func (c CalVer) formatMonth() string {
+	if c.monthZeroPad {
+		return fmt.Sprintf("%02d", c.month)
+	}
+	return strconv.Itoa(c.month)
+}
+
+func (c CalVer) formatDay() string {
+	if c.dayZeroPad {
+		return fmt.Sprintf("%02d", c.day)
+	}
+	return strconv.Itoa(c.day)
+}
+
+// String returns the dot-separated date string, followed by the
+// pre-release and build metadata suffixes if present.
+func (c CalVer) String() string {
+	parts := []string{c.formatYear(), c.formatMonth()}
+	if c.hasDay {
+		parts = append(parts, c.formatDay())
+	} else if c.micro != nil {
+		parts = append(parts, strconv.FormatUint(*c.micro, 10))
+	}
+
+	s := strings.Join(parts, ".")
+	if c.preRelease != "" {
+		s += "-" + c.preRelease
+	}
+	if c.buildMetadata != "" {
+		s += "+" + c.buildMetadata
+	}
+	return s
+}
+
+// Date returns the calendar date c was built from, in UTC. Its day is 1 if
+// c's layout has no day component.
+func (c CalVer) Date() time.Time {
+	day := c.day
+	if !c.hasDay {
+		day = 1
+	}
+	return time.Date(c.year, time.Month(c.month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// Micro returns c's micro/release counter. If c has no micro counter, it
+// returns 0.
+func (c CalVer) Micro() uint64 {
+	if c.micro == nil {
+		return 0
+	}
+	return *c.micro
+}
+
+// Compare compares c to other by year, month, and day or micro counter (in
+// that order), then falls back to pre-release precedence the same way
+// GenericVersion.Compare does. It returns a negative number if c is less
+// than other, zero if they are equal (ignoring build metadata), and a
+// positive number if c is greater.
+func (c CalVer) Compare(other CalVer) int {
+	if c.year != other.year {
+		return compareInt(c.year, other.year)
+	}
+	if c.month != other.month {
+		return compareInt(c.month, other.month)
+	}
+	if c.hasDay || other.hasDay {
+		if d := compareInt(c.day, other.day); d != 0 {
+			return d
+		}
+	} else if d := compareInt(int(c.Micro()), int(other.Micro())); d != 0 {
+		return d
+	}
+
+	switch {
+	case c.preRelease == "" && other.preRelease != "":
+		return 1
+	case c.preRelease != "" && other.preRelease == "":
+		return -1
+	case c.preRelease != other.preRelease:
+		if c.preRelease < other.preRelease {
+			return -1
+		}
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Less reports whether c sorts before other.
+func (c CalVer) Less(other CalVer) bool {
+	return c.Compare(other) < 0
+}
+
+// Equal reports whether c and other represent the same calendar version,
+// ignoring build metadata.
+func (c CalVer) Equal(other CalVer) bool {
+	return c.Compare(other) == 0
+}
+
+// Greater reports whether c sorts after other.
+func (c CalVer) Greater(other CalVer) bool {
+	return c.Compare(other) > 0
+}
+
+// IncrementDate returns a copy of c with its date components replaced by
+// t's, preserving c's layout (width, padding, and whether it carries a day
+// or a micro counter) and resetting any micro counter to zero.
+func (c CalVer) IncrementDate(t time.Time) CalVer {
+	next := c
+	next.year = t.Year()
+	next.month = int(t.Month())
+	if next.hasDay {
+		next.day = t.Day()
+	}
+	if next.micro != nil {
+		zero := uint64(0)
+		next.micro = &zero
+	}
+	return next
+}
+
+// Versioner is a minimal interface implemented by both Version and CalVer,
+// letting callers that mix SemVer and calendar-based releases in one list
+// sort or compare them uniformly without committing to a single scheme.
+type Versioner interface {
+	String() string
+	LessThan(other Versioner) bool
+}
+
+// LessThan reports whether v sorts before other under the Versioner
+// interface. Against another Version it matches Less exactly; against a
+// CalVer or any other Versioner, the two schemes share no notion of
+// precedence, so it falls back to comparing their String forms.
+func (v Version) LessThan(other Versioner) bool {
+	if otherVersion, ok := other.(Version); ok {
+		return v.Less(otherVersion)
+	}
+	return v.String() < other.String()
+}
+
+// LessThan reports whether c sorts before other under the Versioner
+// interface. Against another CalVer it matches Less exactly; against a
+// Version or any other Versioner, it falls back to comparing their String
+// forms, since the two schemes share no notion of precedence.
+func (c CalVer) LessThan(other Versioner) bool {
+	if otherCalVer, ok := other.(CalVer); ok {
+		return c.Less(otherCalVer)
+	}
+	return c.String() < other.String()
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
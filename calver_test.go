@@ -0,0 +1,85 @@
+package verlib_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestParseCalVerDateForm(t *testing.T) {
+	c := verlib.MustParseCalVer("2024.05.17")
+
+	if c.String() != "2024.05.17" {
+		t.Errorf("got %q, expected %q", c.String(), "2024.05.17")
+	}
+
+	expectedDate := time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC)
+	if !c.Date().Equal(expectedDate) {
+		t.Errorf("got %v, expected %v", c.Date(), expectedDate)
+	}
+}
+
+func TestParseCalVerShortYear(t *testing.T) {
+	c := verlib.MustParseCalVer("24.05")
+
+	if c.String() != "24.05" {
+		t.Errorf("got %q, expected %q", c.String(), "24.05")
+	}
+}
+
+func TestParseCalVerMicro(t *testing.T) {
+	c := verlib.MustParseCalVer("20.04.142")
+
+	if c.Micro() != 142 {
+		t.Errorf("got %d, expected 142", c.Micro())
+	}
+	if c.String() != "20.04.142" {
+		t.Errorf("got %q, expected %q", c.String(), "20.04.142")
+	}
+}
+
+func TestCalVerCompare(t *testing.T) {
+	if !verlib.MustParseCalVer("2024.05.17").Less(verlib.MustParseCalVer("2024.06.01")) {
+		t.Error("expected 2024.05.17 to sort before 2024.06.01")
+	}
+	if !verlib.MustParseCalVer("2023.12.31").Less(verlib.MustParseCalVer("2024.01.01")) {
+		t.Error("expected 2023.12.31 to sort before 2024.01.01")
+	}
+	if !verlib.MustParseCalVer("2024.05.17").Equal(verlib.MustParseCalVer("2024.05.17")) {
+		t.Error("expected identical calvers to be equal")
+	}
+}
+
+func TestCalVerIncrementDate(t *testing.T) {
+	c := verlib.NewCalVerMicro("2006.01.2", time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC), 3)
+
+	next := c.IncrementDate(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+
+	if next.Micro() != 0 {
+		t.Errorf("got micro %d, expected 0 after a date bump", next.Micro())
+	}
+	if next.String() != "2024.06.0" {
+		t.Errorf("got %q, expected %q", next.String(), "2024.06.0")
+	}
+}
+
+func TestVersionerMixedSort(t *testing.T) {
+	var versioners []verlib.Versioner
+	versioners = append(versioners,
+		verlib.MustParseCalVer("2024.05.17"),
+		verlib.NewVersion(1, 2, 3),
+	)
+
+	sort.Slice(versioners, func(i, j int) bool {
+		return versioners[i].LessThan(versioners[j])
+	})
+
+	if versioners[0].String() != "1.2.3" {
+		t.Errorf("got %q, expected %q", versioners[0].String(), "1.2.3")
+	}
+	if versioners[1].String() != "2024.05.17" {
+		t.Errorf("got %q, expected %q", versioners[1].String(), "2024.05.17")
+	}
+}
@@ -0,0 +1,248 @@
+// Command verlib wraps the verlib package for use in shell scripts and CI
+// pipelines: parsing, bumping, comparing, and sorting versions without
+// reaching for ad-hoc shell parsing.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+// buildVersion reports the library version this binary was built against.
+// It is overridden at build time via:
+//
+//	go build -ldflags "-X main.buildVersion=v1.2.3"
+var buildVersion = "dev"
+
+func main() {
+	args, jsonMode := extractJSONFlag(os.Args[1:])
+	if len(args) == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch args[0] {
+	case "parse":
+		err = runParse(args[1:], jsonMode)
+	case "bump":
+		err = runBump(args[1:], jsonMode)
+	case "compare":
+		err = runCompare(args[1:])
+	case "satisfies":
+		err = runSatisfies(args[1:])
+	case "sort":
+		err = runSort(args[1:])
+	case "strict":
+		err = runStrict(args[1:])
+	case "version":
+		fmt.Println(buildVersion)
+	default:
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "verlib:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: verlib [--json] <command> [arguments]
+
+commands:
+  parse <version>               parse and print a normalized version
+  bump <major|minor|patch|pessimistic> <version>
+  compare <a> <b>                print -1, 0, or 1; exit 0/1/2 for eq/lt/gt
+  satisfies <version> <constraint>
+  sort                           read versions from stdin, write sorted
+  strict <version>               print StrictString(), or fail
+  version                        print the library version this binary embeds`)
+}
+
+func extractJSONFlag(args []string) ([]string, bool) {
+	out := make([]string, 0, len(args))
+	jsonMode := false
+	for _, arg := range args {
+		if arg == "--json" {
+			jsonMode = true
+			continue
+		}
+		out = append(out, arg)
+	}
+	return out, jsonMode
+}
+
+type jsonVersion struct {
+	Major         uint64 `json:"major"`
+	Minor         uint64 `json:"minor"`
+	Patch         uint64 `json:"patch"`
+	PreRelease    string `json:"preRelease"`
+	BuildMetadata string `json:"buildMetadata"`
+}
+
+func toJSONVersion(v verlib.Version) jsonVersion {
+	return jsonVersion{
+		Major:         v.Major(),
+		Minor:         v.Minor(),
+		Patch:         v.Patch(),
+		PreRelease:    v.PreRelease(),
+		BuildMetadata: v.BuildMetadata(),
+	}
+}
+
+func printVersion(v verlib.Version, jsonMode bool) error {
+	if !jsonMode {
+		fmt.Println(v.String())
+		return nil
+	}
+
+	data, err := json.Marshal(toJSONVersion(v))
+	if err != nil {
+		return fmt.Errorf("failed to encode version as JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+func runParse(args []string, jsonMode bool) error {
+	if len(args) != 1 {
+		return fmt.Errorf("parse requires exactly one version argument")
+	}
+
+	v, err := verlib.ParseVersionLenient(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to parse %q: %w", args[0], err)
+	}
+	return printVersion(v, jsonMode)
+}
+
+func runBump(args []string, jsonMode bool) error {
+	if len(args) != 2 {
+		return fmt.Errorf("bump requires a kind (major|minor|patch|pessimistic) and a version argument")
+	}
+
+	v, err := verlib.ParseVersionLenient(args[1])
+	if err != nil {
+		return fmt.Errorf("failed to parse %q: %w", args[1], err)
+	}
+
+	var bumped verlib.Version
+	switch args[0] {
+	case "major":
+		bumped = v.IncrementMajor()
+	case "minor":
+		bumped = v.IncrementMinor()
+	case "patch":
+		bumped = v.IncrementPatch()
+	case "pessimistic":
+		bumped = v.IncrementPessimistic()
+	default:
+		return fmt.Errorf("unknown bump kind %q: expected major, minor, patch, or pessimistic", args[0])
+	}
+
+	return printVersion(bumped, jsonMode)
+}
+
+func runCompare(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("compare requires exactly two version arguments")
+	}
+
+	a, err := verlib.ParseVersionLenient(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to parse %q: %w", args[0], err)
+	}
+	b, err := verlib.ParseVersionLenient(args[1])
+	if err != nil {
+		return fmt.Errorf("failed to parse %q: %w", args[1], err)
+	}
+
+	switch {
+	case a.Equal(b):
+		fmt.Println(0)
+		os.Exit(0)
+	case a.Less(b):
+		fmt.Println(-1)
+		os.Exit(1)
+	default:
+		fmt.Println(1)
+		os.Exit(2)
+	}
+	return nil
+}
+
+func runSatisfies(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("satisfies requires a version and a constraint expression argument")
+	}
+
+	v, err := verlib.ParseVersionLenient(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to parse %q: %w", args[0], err)
+	}
+
+	expr, err := verlib.ParseConstraints(args[1])
+	if err != nil {
+		return fmt.Errorf("failed to parse constraint %q: %w", args[1], err)
+	}
+
+	if !expr.Check(v) {
+		os.Exit(1)
+	}
+	return nil
+}
+
+func runSort(args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("sort takes no arguments; versions are read from stdin")
+	}
+
+	var versions verlib.Collection
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		v, err := verlib.ParseVersionLenient(line)
+		if err != nil {
+			return fmt.Errorf("failed to parse %q: %w", line, err)
+		}
+		versions = append(versions, v)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read stdin: %w", err)
+	}
+
+	sort.Sort(versions)
+	for _, v := range versions {
+		fmt.Println(v.String())
+	}
+	return nil
+}
+
+func runStrict(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("strict requires exactly one version argument")
+	}
+
+	v, err := verlib.ParseVersionLenient(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to parse %q: %w", args[0], err)
+	}
+
+	strictString, err := v.StrictString()
+	if err != nil {
+		return fmt.Errorf("failed to produce a strict version string for %q: %w", args[0], err)
+	}
+	fmt.Println(strictString)
+	return nil
+}
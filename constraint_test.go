@@ -37,6 +37,16 @@ func TestCheckSatisfy(t *testing.T) {
 		{verlib.NewVersion(1, 2, 3), verlib.NewConstraint(verlib.GEPessimistic, verlib.NewVersion(1, 2, 3)), true},
 		{verlib.NewVersion(1, 2, 5), verlib.NewConstraint(verlib.GEPessimistic, verlib.NewVersion(1, 2, 4)), true},
 		{verlib.NewVersion(1, 2, 3), verlib.NewConstraint(verlib.GEPessimistic, verlib.NewVersion(1, 3, 0)), false},
+		// Caret: compatible with, leftmost non-zero component held fixed
+		{verlib.NewVersion(1, 9, 9), verlib.NewConstraint(verlib.Caret, verlib.NewVersion(1, 2, 3)), true},
+		{verlib.NewVersion(2, 0, 0), verlib.NewConstraint(verlib.Caret, verlib.NewVersion(1, 2, 3)), false},
+		{verlib.NewVersion(0, 2, 9), verlib.NewConstraint(verlib.Caret, verlib.NewVersion(0, 2, 3)), true},
+		{verlib.NewVersion(0, 3, 0), verlib.NewConstraint(verlib.Caret, verlib.NewVersion(0, 2, 3)), false},
+		{verlib.NewVersion(0, 0, 3), verlib.NewConstraint(verlib.Caret, verlib.NewVersion(0, 0, 3)), true},
+		{verlib.NewVersion(0, 0, 4), verlib.NewConstraint(verlib.Caret, verlib.NewVersion(0, 0, 3)), false},
+		// Tilde: patch-level changes when minor is specified, minor-level otherwise
+		{verlib.NewVersion(1, 2, 9), verlib.NewConstraint(verlib.Tilde, verlib.NewVersion(1, 2, 3)), true},
+		{verlib.NewVersion(1, 3, 0), verlib.NewConstraint(verlib.Tilde, verlib.NewVersion(1, 2, 3)), false},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,96 @@
+package verlib
+
+import "fmt"
+
+// ParseConstraints parses a constraint string that may combine caret,
+// tilde, hyphen, and wildcard ranges with `||`-separated alternatives, the
+// syntax popularized by npm and adopted by Masterminds/semver. It is a
+// thin, npm-flavoured (CompatNPM) wrapper around ParseConstraintExpression
+// for callers who don't need to pick a Compat mode explicitly.
+func ParseConstraints(expression string) (ConstraintExpression, error) {
+	return ParseConstraintExpression(expression, CompatNPM)
+}
+
+// ParseConstraintExpr is an alias for ParseConstraints, provided under the
+// name used by some downstream tooling that expects a dedicated
+// "constraint expression" parser distinct from the plain AND-only
+// ParseConstraintSet.
+func ParseConstraintExpr(expression string) (ConstraintExpression, error) {
+	return ParseConstraints(expression)
+}
+
+// Check reports whether v satisfies at least one alternative of ce. It is
+// an alias for Satisfies, named to match the Check/Validate vocabulary used
+// elsewhere in this package's constraint-matching API.
+func (ce ConstraintExpression) Check(v Version) bool {
+	return ce.Satisfies(v)
+}
+
+// Validate reports whether v satisfies ce, like Check, but additionally
+// returns one error per constraint that v failed, across every
+// alternative. If ce is satisfied, the returned slice is nil even though
+// some alternatives may have failed.
+func (ce ConstraintExpression) Validate(v Version) (bool, []error) {
+	var failures []error
+	for _, alternative := range ce {
+		if failureErrs := alternative.validate(v); len(failureErrs) == 0 {
+			return true, nil
+		} else {
+			failures = append(failures, failureErrs...)
+		}
+	}
+
+	return false, failures
+}
+
+// HighestMatching returns the highest Version in candidates that satisfies
+// every constraint in c, and false if none do. This is the operation a
+// package manager actually performs when resolving a dependency range
+// against the versions a registry offers, complementing the lower-level
+// yes/no answers Contradicts and Simplify give.
+func (c Constraints) HighestMatching(candidates []Version) (Version, bool) {
+	var best Version
+	found := false
+
+	for _, candidate := range candidates {
+		if !candidate.SatisfiesAll(c) {
+			continue
+		}
+		if !found || best.Less(candidate) {
+			best = candidate
+			found = true
+		}
+	}
+
+	return best, found
+}
+
+// LowestMatching returns the lowest Version in candidates that satisfies
+// every constraint in c, and false if none do.
+func (c Constraints) LowestMatching(candidates []Version) (Version, bool) {
+	var best Version
+	found := false
+
+	for _, candidate := range candidates {
+		if !candidate.SatisfiesAll(c) {
+			continue
+		}
+		if !found || candidate.Less(best) {
+			best = candidate
+			found = true
+		}
+	}
+
+	return best, found
+}
+
+// validate returns one error per constraint in c that v fails to satisfy.
+func (c Constraints) validate(v Version) []error {
+	var failures []error
+	for _, constraint := range c {
+		if !v.SatisfiesWith(constraint) {
+			failures = append(failures, fmt.Errorf("%s does not satisfy %s", v.String(), constraint.String()))
+		}
+	}
+	return failures
+}
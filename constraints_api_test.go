@@ -0,0 +1,102 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestParseConstraintsCheck(t *testing.T) {
+	expr, err := verlib.ParseConstraints(">=1.2.0, <2.0.0 || >=3.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !expr.Check(verlib.NewVersion(1, 5, 0)) {
+		t.Error("expected 1.5.0 to satisfy the first alternative")
+	}
+	if !expr.Check(verlib.NewVersion(3, 1, 0)) {
+		t.Error("expected 3.1.0 to satisfy the second alternative")
+	}
+	if expr.Check(verlib.NewVersion(2, 5, 0)) {
+		t.Error("expected 2.5.0 to satisfy neither alternative")
+	}
+}
+
+func TestConstraintExpressionValidate(t *testing.T) {
+	expr, err := verlib.ParseConstraints(">=1.2.0, <2.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ok, errs := expr.Validate(verlib.NewVersion(1, 5, 0)); !ok || len(errs) != 0 {
+		t.Errorf("expected 1.5.0 to validate cleanly, got ok=%t errs=%v", ok, errs)
+	}
+
+	ok, errs := expr.Validate(verlib.NewVersion(2, 5, 0))
+	if ok {
+		t.Error("expected 2.5.0 to fail validation")
+	}
+	if len(errs) == 0 {
+		t.Error("expected at least one validation error")
+	}
+}
+
+func TestConstraintsHighestMatching(t *testing.T) {
+	constraints := verlib.Constraints{
+		verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0)),
+		verlib.NewConstraint(verlib.LT, verlib.NewVersion(2, 0, 0)),
+	}
+	candidates := []verlib.Version{
+		verlib.NewVersion(0, 9, 0),
+		verlib.NewVersion(1, 2, 0),
+		verlib.NewVersion(1, 9, 0),
+		verlib.NewVersion(2, 0, 0),
+	}
+
+	best, ok := constraints.HighestMatching(candidates)
+	if !ok {
+		t.Fatal("expected a highest matching version")
+	}
+	if !best.Equal(verlib.NewVersion(1, 9, 0)) {
+		t.Errorf("got %s, expected 1.9.0", best.String())
+	}
+
+	if _, ok := constraints.HighestMatching([]verlib.Version{verlib.NewVersion(3, 0, 0)}); ok {
+		t.Error("expected no match among candidates outside the range")
+	}
+}
+
+func TestConstraintsLowestMatching(t *testing.T) {
+	constraints := verlib.Constraints{
+		verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0)),
+		verlib.NewConstraint(verlib.LT, verlib.NewVersion(2, 0, 0)),
+	}
+	candidates := []verlib.Version{
+		verlib.NewVersion(0, 9, 0),
+		verlib.NewVersion(1, 2, 0),
+		verlib.NewVersion(1, 9, 0),
+	}
+
+	lowest, ok := constraints.LowestMatching(candidates)
+	if !ok {
+		t.Fatal("expected a lowest matching version")
+	}
+	if !lowest.Equal(verlib.NewVersion(1, 2, 0)) {
+		t.Errorf("got %s, expected 1.2.0", lowest.String())
+	}
+}
+
+func TestParseConstraintExpr(t *testing.T) {
+	expr, err := verlib.ParseConstraintExpr("1.2.3 - 2.3.4 || ^3.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !expr.Check(verlib.NewVersion(2, 0, 0)) {
+		t.Error("expected 2.0.0 to satisfy the hyphen range alternative")
+	}
+	if !expr.Check(verlib.NewVersion(3, 5, 0)) {
+		t.Error("expected 3.5.0 to satisfy the caret alternative")
+	}
+}
@@ -45,6 +45,12 @@ func checkContradict(c1, c2 Constraint) bool {
 
 // Contradicts checks if any constraints in the Constraints and additional contradict each other.
 // If contradiction exists, an error containing all contradictory pairs is returned.
+//
+// Contradicts reports every contradictory pair it finds via pairwise
+// comparison; its natural companion Simplify instead reduces the whole set
+// down to a single equivalent interval (or a ContradictionErr, in the
+// unsatisfiable case) and is the cheaper check when all that's needed is a
+// yes/no answer plus a minimal reduced set.
 func (c Constraints) Contradicts(additional ...Constraints) error {
 	allConstraints := append(make(Constraints, 0, len(c)), c...)
 
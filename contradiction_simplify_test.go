@@ -0,0 +1,49 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+// TestContradictsAgreesWithSimplify guards the invariant that Contradicts
+// and Simplify agree on satisfiability: a set is unsatisfiable under one
+// exactly when it is under the other.
+func TestContradictsAgreesWithSimplify(t *testing.T) {
+	testCases := []struct {
+		name              string
+		constraints       verlib.Constraints
+		wantUnsatisfiable bool
+	}{
+		{
+			name: "contradictory bounds",
+			constraints: verlib.Constraints{
+				verlib.NewConstraint(verlib.GE, verlib.NewVersion(2, 0, 0)),
+				verlib.NewConstraint(verlib.LT, verlib.NewVersion(1, 5, 0)),
+			},
+			wantUnsatisfiable: true,
+		},
+		{
+			name: "compatible bounds",
+			constraints: verlib.Constraints{
+				verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0)),
+				verlib.NewConstraint(verlib.LT, verlib.NewVersion(2, 0, 0)),
+			},
+			wantUnsatisfiable: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contradicts := tc.constraints.Contradicts() != nil
+			if contradicts != tc.wantUnsatisfiable {
+				t.Errorf("Contradicts() reported contradictory=%t, expected %t", contradicts, tc.wantUnsatisfiable)
+			}
+
+			_, err := tc.constraints.Simplify()
+			if unsatisfiable := err != nil; unsatisfiable != tc.wantUnsatisfiable {
+				t.Errorf("Simplify() reported unsatisfiable=%t, expected %t", unsatisfiable, tc.wantUnsatisfiable)
+			}
+		})
+	}
+}
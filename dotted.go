@@ -0,0 +1,66 @@
+package verlib
+
+import "fmt"
+
+// Kind distinguishes the version scheme a Version was parsed under, letting
+// operators that compute a derived bound - currently IncrementPessimistic,
+// used by the `~>` constraint - choose scheme-appropriate semantics for the
+// same major/minor/patch/Extra fields.
+type Kind int
+
+const (
+	// KindSemVer is the default Kind, for ordinary three-component Semantic
+	// Versioning: IncrementPessimistic pins at whichever of minor/patch is
+	// present.
+	KindSemVer Kind = iota
+	// KindDotted marks a Version parsed by ParseDotted: an arbitrary number
+	// of dot-separated numeric segments, as seen in Windows/.NET assembly
+	// versions, Java build numbers, and hashicorp/go-version. On a
+	// KindDotted Version, IncrementPessimistic pins at whichever trailing
+	// segment - including one held in Extra - the input actually specified.
+	KindDotted
+)
+
+// ParseDotted parses a version string with an arbitrary number of
+// dot-separated numeric segments - "1.2.3.4", "1.2.0.4-x.Y.0+metadata", or
+// just "1.2" - as seen in Windows/.NET assembly versions, Java build
+// numbers, and hashicorp/go-version. ParseVersionLenient does the actual
+// parsing and component/Extra assignment; ParseDotted additionally tags
+// the result's Kind as KindDotted, which is what lets `~>` pin at a segment
+// beyond patch. Strict SemVer parsing via ParseVersion/ParseSemVer is
+// unaffected.
+func ParseDotted(s string) (Version, error) {
+	v, err := ParseVersionLenient(s)
+	if err != nil {
+		return Version{}, err
+	}
+	v.Kind = KindDotted
+	return v, nil
+}
+
+// MustParseDotted is similar to ParseDotted, but it panics if the parsing
+// fails.
+func MustParseDotted(s string) Version {
+	v, err := ParseDotted(s)
+	if err != nil {
+		panic(fmt.Errorf("failed to parse dotted version: %w", err))
+	}
+	return v
+}
+
+// Segments returns every numeric component of v, in order: major, then
+// minor and patch if present, followed by any components held in Extra.
+// Unlike Major/Minor/Patch, which zero-default a missing component,
+// Segments reports only the segments v was actually given.
+func (v Version) Segments() []uint64 {
+	segments := make([]uint64, 0, 3+len(v.Extra))
+	segments = append(segments, v.major)
+	if v.minor != nil {
+		segments = append(segments, *v.minor)
+	}
+	if v.patch != nil {
+		segments = append(segments, *v.patch)
+	}
+	segments = append(segments, v.Extra...)
+	return segments
+}
@@ -0,0 +1,64 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestParseDottedEquality(t *testing.T) {
+	if !verlib.MustParseDotted("1.2.0.0").Equal(verlib.MustParseDotted("1.2")) {
+		t.Error("expected 1.2.0.0 to equal 1.2")
+	}
+	if !verlib.MustParseDotted("1.2.0.0.1").Greater(verlib.MustParseDotted("1.2")) {
+		t.Error("expected 1.2.0.0.1 to be greater than 1.2")
+	}
+}
+
+func TestParseDottedSegments(t *testing.T) {
+	v := verlib.MustParseDotted("1.2.3.4.5")
+	expected := []uint64{1, 2, 3, 4, 5}
+
+	segments := v.Segments()
+	if len(segments) != len(expected) {
+		t.Fatalf("got %v, expected %v", segments, expected)
+	}
+	for i := range expected {
+		if segments[i] != expected[i] {
+			t.Errorf("got %v, expected %v", segments, expected)
+		}
+	}
+}
+
+func TestDottedPessimisticPinsAtFourthSegment(t *testing.T) {
+	constraint := verlib.NewConstraint(verlib.GEPessimistic, verlib.MustParseDotted("1.2.3.4"))
+
+	if !verlib.MustParseDotted("1.2.3.9").Satisfies(constraint) {
+		t.Error("expected ~> 1.2.3.4 to allow 1.2.3.9")
+	}
+	if verlib.MustParseDotted("1.2.4.0").Satisfies(constraint) {
+		t.Error("expected ~> 1.2.3.4 to reject 1.2.4.0")
+	}
+}
+
+func TestDottedPessimisticPinsAtFifthSegment(t *testing.T) {
+	constraint := verlib.NewConstraint(verlib.GEPessimistic, verlib.MustParseDotted("1.2.3.4.5"))
+
+	if !verlib.MustParseDotted("1.2.3.4.9").Satisfies(constraint) {
+		t.Error("expected ~> 1.2.3.4.5 to allow 1.2.3.4.9")
+	}
+	if verlib.MustParseDotted("1.2.3.5.0").Satisfies(constraint) {
+		t.Error("expected ~> 1.2.3.4.5 to reject 1.2.3.5.0")
+	}
+}
+
+func TestPlainVersionPessimisticUnaffectedByKind(t *testing.T) {
+	constraint := verlib.NewConstraint(verlib.GEPessimistic, verlib.NewVersion(1, 2, 3))
+
+	if !verlib.NewVersion(1, 2, 9).Satisfies(constraint) {
+		t.Error("expected ~> 1.2.3 to still allow 1.2.9")
+	}
+	if verlib.NewVersion(1, 3, 0).Satisfies(constraint) {
+		t.Error("expected ~> 1.2.3 to still reject 1.3.0")
+	}
+}
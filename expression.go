@@ -0,0 +1,94 @@
+package verlib
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ConstraintExpression represents a disjunction ("OR") of Constraints
+// alternatives. A Version satisfies a ConstraintExpression if it satisfies
+// at least one of the alternatives, each of which is itself an AND-group of
+// Constraint values.
+type ConstraintExpression []Constraints
+
+// ConstraintOrSet is an alias for ConstraintExpression, provided under the
+// name used by package.json-style tooling that thinks of a `||`-separated
+// requirement string as a set of OR'd alternatives rather than an
+// "expression". It is the same type, not a copy, so values are
+// interchangeable with no conversion.
+type ConstraintOrSet = ConstraintExpression
+
+// Satisfies determines whether a given Version satisfies at least one
+// alternative of the ConstraintExpression.
+func (ce ConstraintExpression) Satisfies(v Version) bool {
+	for _, alternative := range ce {
+		if v.SatisfiesAll(alternative) {
+			return true
+		}
+	}
+	return false
+}
+
+// SatisfiesAll determines whether v satisfies every Constraint in c. Like
+// the npm/Cargo range matchers this package's range parsers build on, a
+// pre-release Version only satisfies a constraint whose own boundary
+// carries a pre-release of the same major.minor.patch line, unless that
+// constraint was built with WithIncludePrerelease(true) - see SatisfiesWith
+// for the exact rule applied per constraint.
+func (v Version) SatisfiesAll(c Constraints) bool {
+	for _, constraint := range c {
+		if !v.SatisfiesWith(constraint) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a string representation of the ConstraintExpression, with
+// alternatives joined by " || " and constraints within an alternative
+// joined by Constraints.String.
+func (ce ConstraintExpression) String() string {
+	alternatives := make([]string, 0, len(ce))
+	for _, alternative := range ce {
+		alternatives = append(alternatives, alternative.String())
+	}
+	return strings.Join(alternatives, " || ")
+}
+
+// StrictString returns a strict string representation of the
+// ConstraintExpression, failing if any alternative fails to produce one.
+func (ce ConstraintExpression) StrictString() (string, error) {
+	alternatives := make([]string, 0, len(ce))
+	for _, alternative := range ce {
+		alternativeString, err := alternative.StrictString()
+		if err != nil {
+			return "", fmt.Errorf("failed to generate strict string for alternative: %w", err)
+		}
+		alternatives = append(alternatives, alternativeString)
+	}
+	return strings.Join(alternatives, " || "), nil
+}
+
+// Contradicts reports whether every alternative in the ConstraintExpression
+// is internally contradictory, meaning no version could ever satisfy the
+// expression as a whole. If at least one alternative is satisfiable, nil is
+// returned even if other alternatives are contradictory.
+func (ce ConstraintExpression) Contradicts() error {
+	if len(ce) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, alternative := range ce {
+		if alternativeErr := alternative.Contradicts(); alternativeErr != nil {
+			err = errors.Join(err, alternativeErr)
+			continue
+		}
+		// At least one alternative is satisfiable, so the expression as a
+		// whole is not contradictory.
+		return nil
+	}
+
+	return fmt.Errorf("every alternative of the constraint expression is contradictory: %w", err)
+}
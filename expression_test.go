@@ -0,0 +1,28 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestConstraintOrSetIsConstraintExpression(t *testing.T) {
+	parsed, err := verlib.ParseConstraints("^1.2.3 || ~2.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// ConstraintOrSet is an alias, so a ConstraintExpression assigns to it
+	// with no conversion and behaves identically.
+	var orSet verlib.ConstraintOrSet = parsed
+
+	if !orSet.Satisfies(verlib.NewVersion(1, 2, 3)) {
+		t.Error("expected ^1.2.3 || ~2.0.0 to satisfy 1.2.3")
+	}
+	if !orSet.Satisfies(verlib.NewVersion(2, 0, 1)) {
+		t.Error("expected ^1.2.3 || ~2.0.0 to satisfy 2.0.1")
+	}
+	if orSet.Satisfies(verlib.NewVersion(3, 0, 0)) {
+		t.Error("expected ^1.2.3 || ~2.0.0 to not satisfy 3.0.0")
+	}
+}
@@ -0,0 +1,79 @@
+package verlib_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+// FuzzParseVersion guards ParseVersion and the lenient/tolerant parsers
+// (ParseVersionLenient, ParseTolerant) against panics on malformed
+// input. The seed corpus is derived from inputs that have historically
+// tripped up lax semver regexes in vendored copies of similar libraries:
+// empty strings, very long digit runs, and versions with more than three
+// numeric components.
+func FuzzParseVersion(f *testing.F) {
+	seeds := []string{
+		"",
+		"1",
+		"1.2",
+		"1.2.3",
+		"v1.2.3",
+		"V1.2.3",
+		"1.2.3.4",
+		"1.2.3.4.5",
+		"1.2.3-alpha+build",
+		"1.7rc2",
+		" 1.2.3 ",
+		"1_2_3",
+		strings.Repeat("9", 100),
+		"-1.2.3",
+		"1.2.3-",
+		"...",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Fatalf("ParseVersion panicked on %q: %v", s, r)
+			}
+		}()
+		_, _ = verlib.ParseVersion(s)
+		_, _ = verlib.ParseVersionLenient(s)
+		_, _ = verlib.ParseTolerant(s)
+	})
+}
+
+// FuzzParseConstraint guards ParseConstraint against panics on malformed
+// constraint strings, using the same style of tricky seed inputs as
+// FuzzParseVersion plus a spread of operator prefixes.
+func FuzzParseConstraint(f *testing.F) {
+	seeds := []string{
+		"",
+		"1.2.3",
+		">=1.2.3",
+		"~>1.2.3",
+		"^1.2.3",
+		"~1.2.3",
+		"!=1.2.3.4",
+		strings.Repeat(">", 20) + "1",
+		"=",
+		"v1.2.3",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Fatalf("ParseConstraint panicked on %q: %v", s, r)
+			}
+		}()
+		_, _ = verlib.ParseConstraint(s)
+	})
+}
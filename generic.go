@@ -0,0 +1,151 @@
+package verlib
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GenericVersion represents a version with an arbitrary number of
+// dot-separated numeric components, for schemes SemVer can't express
+// directly: 4+ component versions ("1.2.3.4"), CalVer ("2024.05.17"), and
+// similar. Unlike Version, it does not assume a major/minor/patch shape.
+type GenericVersion struct {
+	components    []uint64
+	preRelease    string
+	buildMetadata string
+}
+
+// ParseGeneric parses a version string composed of one or more
+// dot-separated numeric components, with an optional "-prerelease" and
+// "+buildmetadata" suffix following the same grammar as SemVer.
+func ParseGeneric(s string) (GenericVersion, error) {
+	original := s
+
+	var buildMetadata string
+	if idx := strings.Index(s, "+"); idx >= 0 {
+		buildMetadata = s[idx+1:]
+		s = s[:idx]
+	}
+
+	var preRelease string
+	if idx := strings.Index(s, "-"); idx >= 0 {
+		preRelease = s[idx+1:]
+		s = s[:idx]
+	}
+
+	if s == "" {
+		return GenericVersion{}, fmt.Errorf("no numeric version components found in %q", original)
+	}
+
+	rawComponents := strings.Split(s, ".")
+	components := make([]uint64, 0, len(rawComponents))
+	for _, raw := range rawComponents {
+		component, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return GenericVersion{}, fmt.Errorf("failed to parse version component %q in %q: %w", raw, original, err)
+		}
+		components = append(components, component)
+	}
+
+	return GenericVersion{
+		components:    components,
+		preRelease:    preRelease,
+		buildMetadata: buildMetadata,
+	}, nil
+}
+
+// MustParseGeneric is similar to ParseGeneric, but it panics if the parsing
+// fails. It's useful when you're certain the input version string is valid,
+// and any failure is a programming error that should stop the program
+// execution.
+func MustParseGeneric(s string) GenericVersion {
+	v, err := ParseGeneric(s)
+	if err != nil {
+		panic(fmt.Errorf("failed to parse generic version: %w", err))
+	}
+	return v
+}
+
+// Components returns the parsed numeric components of g, in order.
+func (g GenericVersion) Components() []uint64 {
+	return append([]uint64(nil), g.components...)
+}
+
+// String returns the dot-separated component string, followed by the
+// pre-release and build metadata suffixes if present.
+func (g GenericVersion) String() string {
+	parts := make([]string, len(g.components))
+	for i, c := range g.components {
+		parts[i] = strconv.FormatUint(c, 10)
+	}
+
+	s := strings.Join(parts, ".")
+	if g.preRelease != "" {
+		s += "-" + g.preRelease
+	}
+	if g.buildMetadata != "" {
+		s += "+" + g.buildMetadata
+	}
+	return s
+}
+
+// Compare compares g to other component-by-component, left to right,
+// padding whichever has fewer components with zeros. It returns a negative
+// number if g is less than other, zero if they are equal (ignoring build
+// metadata), and a positive number if g is greater.
+func (g GenericVersion) Compare(other GenericVersion) int {
+	if c := compareExtra(g.components, other.components); c != 0 {
+		return c
+	}
+
+	switch {
+	case g.preRelease == "" && other.preRelease != "":
+		return 1
+	case g.preRelease != "" && other.preRelease == "":
+		return -1
+	case g.preRelease != other.preRelease:
+		if g.preRelease < other.preRelease {
+			return -1
+		}
+		return 1
+	default:
+		return 0
+	}
+}
+
+// AtLeast reports whether g is greater than or equal to other, so callers
+// writing feature-gating code (e.g. "kubernetes >= 1.19") don't have to
+// force every version scheme through strict SemVer.
+func (g GenericVersion) AtLeast(other GenericVersion) bool {
+	return g.Compare(other) >= 0
+}
+
+// SemVer converts g to a Version, treating the first three components as
+// major, minor, and patch (missing components default to zero) and
+// returning an error if g has more than three components, since those
+// can't be represented without loss.
+func (g GenericVersion) SemVer() (Version, error) {
+	if len(g.components) > 3 {
+		return Version{}, fmt.Errorf("generic version %q has %d components, cannot be represented as SemVer without loss", g.String(), len(g.components))
+	}
+
+	var major, minor, patch uint64
+	if len(g.components) > 0 {
+		major = g.components[0]
+	}
+	if len(g.components) > 1 {
+		minor = g.components[1]
+	}
+	if len(g.components) > 2 {
+		patch = g.components[2]
+	}
+
+	return Version{
+		major:         major,
+		minor:         &minor,
+		patch:         &patch,
+		preRelease:    g.preRelease,
+		buildMetadata: g.buildMetadata,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestParseGeneric(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  string
+	}{
+		{"1.2.3.4", "1.2.3.4"},
+		{"2024.05.17", "2024.5.17"},
+		{"1.19.0-rc.2", "1.19.0-rc.2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			g, err := verlib.ParseGeneric(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error parsing %q: %v", tc.input, err)
+			}
+			if got := g.String(); got != tc.want {
+				t.Errorf("ParseGeneric(%q).String() = %q, want %q", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGenericVersionCompareAndAtLeast(t *testing.T) {
+	shorter := verlib.MustParseGeneric("1.19")
+	longer := verlib.MustParseGeneric("1.19.0.1")
+
+	if shorter.Compare(longer) >= 0 {
+		t.Error("expected 1.19 to be less than 1.19.0.1 once zero-padded")
+	}
+	if !longer.AtLeast(shorter) {
+		t.Error("expected 1.19.0.1 to be at least 1.19")
+	}
+
+	k8s := verlib.MustParseGeneric("1.19.0")
+	if !k8s.AtLeast(verlib.MustParseGeneric("1.19.0")) {
+		t.Error("expected a version to be at least itself")
+	}
+	if k8s.AtLeast(verlib.MustParseGeneric("1.20.0")) {
+		t.Error("expected 1.19.0 to not be at least 1.20.0")
+	}
+}
+
+func TestGenericVersionSemVer(t *testing.T) {
+	g := verlib.MustParseGeneric("1.19.0-rc.2")
+	v, err := g.SemVer()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Major() != 1 || v.Minor() != 19 || v.Patch() != 0 || v.PreRelease() != "rc.2" {
+		t.Errorf("unexpected SemVer conversion: %+v", v)
+	}
+
+	fourComponent := verlib.MustParseGeneric("1.2.3.4")
+	if _, err := fourComponent.SemVer(); err == nil {
+		t.Error("expected an error converting a 4-component generic version to SemVer")
+	}
+}
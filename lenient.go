@@ -0,0 +1,184 @@
+package verlib
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseVersionLenient parses version strings that real-world tooling
+// produces but that SemVer (and this package's stricter parsers) rejects:
+// a missing minor/patch ("1", "1.2"), a "v"/"V" prefix, four-or-more
+// numeric components ("1.2.3.4"), a pre-release joined without a dash
+// ("1.7rc2"), embedded whitespace, and "_" used as a separator alongside
+// ".". Any numeric components beyond patch are kept on the returned
+// Version's Extra field so round-tripping is possible, and the exact input
+// string is preserved on Version.Original().
+//
+// ParseVersion and ParseSemVer are unaffected by this function and remain
+// the strict entry points.
+func ParseVersionLenient(version string) (Version, error) {
+	original := version
+
+	s := strings.Join(strings.Fields(version), "")
+	s = strings.TrimPrefix(s, "v")
+	s = strings.TrimPrefix(s, "V")
+	s = strings.ReplaceAll(s, "_", ".")
+
+	var buildMetadata string
+	if idx := strings.Index(s, "+"); idx >= 0 {
+		buildMetadata = s[idx+1:]
+		s = s[:idx]
+	}
+
+	var preRelease string
+	if idx := strings.Index(s, "-"); idx >= 0 {
+		preRelease = s[idx+1:]
+		s = s[:idx]
+	} else if idx := firstNonNumericComponentRune(s); idx >= 0 {
+		preRelease = s[idx:]
+		s = s[:idx]
+	}
+
+	if s == "" {
+		return Version{}, fmt.Errorf("no numeric version components found in %q", original)
+	}
+
+	var components []uint64
+	for _, rawComponent := range strings.Split(s, ".") {
+		if rawComponent == "" {
+			continue
+		}
+		component, err := strconv.ParseUint(rawComponent, 10, 64)
+		if err != nil {
+			return Version{}, fmt.Errorf("failed to parse version component %q in %q: %w", rawComponent, original, err)
+		}
+		components = append(components, component)
+	}
+	if len(components) == 0 {
+		return Version{}, fmt.Errorf("no numeric version components found in %q", original)
+	}
+
+	v := Version{
+		major:         components[0],
+		preRelease:    preRelease,
+		buildMetadata: buildMetadata,
+		original:      original,
+	}
+	if len(components) > 1 {
+		minor := components[1]
+		v.minor = &minor
+	}
+	if len(components) > 2 {
+		patch := components[2]
+		v.patch = &patch
+	}
+	if len(components) > 3 {
+		v.Extra = append([]uint64(nil), components[3:]...)
+	}
+
+	return v, nil
+}
+
+// MustParseVersionLenient is similar to ParseVersionLenient, but it panics
+// if the parsing fails. It's useful when you're certain the input version
+// string is valid, and any failure is a programming error that should stop
+// the program execution.
+func MustParseVersionLenient(version string) Version {
+	v, err := ParseVersionLenient(version)
+	if err != nil {
+		panic(fmt.Errorf("failed to parse version leniently: %w", err))
+	}
+	return v
+}
+
+// firstNonNumericComponentRune returns the index of the first character in
+// s, after any leading digits and dots, that is not a digit or a dot - this
+// is how a pre-release joined without a dash (e.g. "1.7rc2") is detected.
+// It returns -1 if s is entirely digits and dots.
+func firstNonNumericComponentRune(s string) int {
+	for i, r := range s {
+		if (r < '0' || r > '9') && r != '.' {
+			return i
+		}
+	}
+	return -1
+}
+
+// ParseConstraintLenient parses a version constraint whose version portion
+// may require ParseVersionLenient, such as "~> v1.2" or ">=1.2.3.4". The
+// operator grammar is identical to ParseConstraint.
+func ParseConstraintLenient(verConstraint string) (Constraint, error) {
+	verConstraint = strings.TrimSpace(verConstraint)
+	constraintMatches := constraintRegex.FindStringSubmatch(verConstraint)
+	if len(constraintMatches) != 3 {
+		return Constraint{}, fmt.Errorf("failed to parse version constraint %q", verConstraint)
+	}
+
+	parsedOperator, rawVersion := Operator(constraintMatches[1]), constraintMatches[2]
+	if parsedOperator == "" {
+		parsedOperator = EQ
+	}
+
+	switch parsedOperator {
+	case EQ, NE, GT, GE, LT, LE, GEPessimistic, Caret, Tilde:
+	default:
+		return Constraint{}, fmt.Errorf("invalid operator %q in constraint %q", parsedOperator, verConstraint)
+	}
+
+	version, err := ParseVersionLenient(rawVersion)
+	if err != nil {
+		return Constraint{}, fmt.Errorf("failed to parse version in constraint: %w", err)
+	}
+
+	return Constraint{
+		operator: parsedOperator,
+		version:  version,
+	}, nil
+}
+
+// MustParseConstraintLenient is similar to ParseConstraintLenient, but it
+// panics if the parsing fails.
+func MustParseConstraintLenient(verConstraint string) Constraint {
+	constraint, err := ParseConstraintLenient(verConstraint)
+	if err != nil {
+		panic(err)
+	}
+	return constraint
+}
+
+// ParseTolerant parses version strings as permissively as ParseVersionLenient,
+// but returns a fully-normalized, dense Version: a missing minor or patch is
+// coerced to zero rather than left nil, and any numeric components beyond
+// patch (e.g. the trailing ".0.0" in "1.2.3.0.0") are dropped rather than
+// kept on Extra. Like ParseVersionLenient, the exact input string is
+// preserved on Version.Original() so callers can display the form the user
+// typed while comparing on the normalized numeric fields. Use
+// ParseVersionLenient instead when round-tripping the extra components
+// beyond patch matters.
+func ParseTolerant(s string) (Version, error) {
+	v, err := ParseVersionLenient(s)
+	if err != nil {
+		return Version{}, err
+	}
+
+	minor, patch := v.Minor(), v.Patch()
+	return Version{
+		major:         v.Major(),
+		minor:         &minor,
+		patch:         &patch,
+		preRelease:    v.PreRelease(),
+		buildMetadata: v.BuildMetadata(),
+		original:      v.original,
+	}, nil
+}
+
+// MustParseTolerant is similar to ParseTolerant, but it panics if the
+// parsing fails.
+func MustParseTolerant(s string) Version {
+	v, err := ParseTolerant(s)
+	if err != nil {
+		panic(fmt.Errorf("failed to parse version tolerantly: %w", err))
+	}
+	return v
+}
@@ -0,0 +1,114 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestParseVersionLenient(t *testing.T) {
+	testCases := []struct {
+		input      string
+		major      uint64
+		minor      uint64
+		patch      uint64
+		extra      []uint64
+		preRelease string
+	}{
+		{"1", 1, 0, 0, nil, ""},
+		{"1.2", 1, 2, 0, nil, ""},
+		{"v1.2.3", 1, 2, 3, nil, ""},
+		{"V1.2.3", 1, 2, 3, nil, ""},
+		{"1.2.3.4", 1, 2, 3, []uint64{4}, ""},
+		{"1.2.3.4.5", 1, 2, 3, []uint64{4, 5}, ""},
+		{"1.7rc2", 1, 7, 0, nil, "rc2"},
+		{" 1.2.3 ", 1, 2, 3, nil, ""},
+		{"1_2_3", 1, 2, 3, nil, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			v, err := verlib.ParseVersionLenient(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error parsing %q: %v", tc.input, err)
+			}
+			if v.Major() != tc.major || v.Minor() != tc.minor || v.Patch() != tc.patch {
+				t.Errorf("got %d.%d.%d, expected %d.%d.%d", v.Major(), v.Minor(), v.Patch(), tc.major, tc.minor, tc.patch)
+			}
+			if v.PreRelease() != tc.preRelease {
+				t.Errorf("got preRelease %q, expected %q", v.PreRelease(), tc.preRelease)
+			}
+			if len(v.Extra) != len(tc.extra) {
+				t.Fatalf("got Extra %v, expected %v", v.Extra, tc.extra)
+			}
+			for i := range tc.extra {
+				if v.Extra[i] != tc.extra[i] {
+					t.Errorf("got Extra[%d] = %d, expected %d", i, v.Extra[i], tc.extra[i])
+				}
+			}
+			if v.Original() != tc.input {
+				t.Errorf("got Original() %q, expected %q", v.Original(), tc.input)
+			}
+		})
+	}
+}
+
+func TestParseVersionLenientExtraOrdering(t *testing.T) {
+	a := verlib.MustParseVersionLenient("1.2.3.4")
+	b := verlib.MustParseVersionLenient("1.2.3.10")
+
+	if !a.Less(b) {
+		t.Error("expected 1.2.3.4 to be less than 1.2.3.10")
+	}
+
+	c := verlib.MustParseVersionLenient("1.2.3")
+	d := verlib.MustParseVersionLenient("1.2.3.0")
+	if !c.Equal(d) {
+		t.Error("expected 1.2.3 to equal 1.2.3.0 (zero-padded Extra)")
+	}
+}
+
+func TestParseConstraintLenient(t *testing.T) {
+	c, err := verlib.ParseConstraintLenient(">= v1.2.3.4")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	v := verlib.MustParseVersionLenient("1.2.3.5")
+	if !v.Satisfies(c) {
+		t.Errorf("expected 1.2.3.5 to satisfy %s", c.String())
+	}
+}
+
+func TestParseTolerant(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  string
+	}{
+		{"1", "1.0.0"},
+		{"v1.2", "1.2.0"},
+		{"V1.2.3", "1.2.3"},
+		{"1.2.3.0.0", "1.2.3"},
+		{"1.2.3.4", "1.2.3"},
+		{"01.02.03", "1.2.3"},
+		{"1.2.00", "1.2.0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			v, err := verlib.ParseTolerant(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error parsing %q: %v", tc.input, err)
+			}
+			if v.String() != tc.want {
+				t.Errorf("ParseTolerant(%q) = %q, want %q", tc.input, v.String(), tc.want)
+			}
+			if len(v.Extra) != 0 {
+				t.Errorf("expected ParseTolerant to drop Extra components, got %v", v.Extra)
+			}
+			if v.Original() != tc.input {
+				t.Errorf("got Original() %q, expected %q", v.Original(), tc.input)
+			}
+		})
+	}
+}
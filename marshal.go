@@ -0,0 +1,206 @@
+package verlib
+
+import "encoding/json"
+
+// MarshalText implements encoding.TextMarshaler, emitting the canonical
+// (non-strict) String() form of v.
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing text with
+// ParseVersion.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := ParseVersion(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, emitting v as a JSON string in its
+// canonical (non-strict) String() form.
+func (v Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, parsing a JSON string with
+// ParseVersion.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+
+	parsed, err := ParseVersion(text)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// Prerelease reports whether v carries a pre-release label, letting callers
+// filter pre-release versions out of API/JSON version manifests.
+func (v Version) Prerelease() bool {
+	return v.preRelease != ""
+}
+
+// MarshalYAML implements the gopkg.in/yaml.v2-style yaml.Marshaler
+// interface, emitting v as a YAML scalar in its canonical (non-strict)
+// String() form.
+func (v Version) MarshalYAML() (interface{}, error) {
+	return v.String(), nil
+}
+
+// UnmarshalYAML implements the gopkg.in/yaml.v2-style yaml.Unmarshaler
+// interface, parsing the scalar with ParseVersion.
+func (v *Version) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var text string
+	if err := unmarshal(&text); err != nil {
+		return err
+	}
+
+	parsed, err := ParseVersion(text)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, emitting the canonical
+// (non-strict) String() form of c.
+func (c Constraint) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing text with
+// ParseConstraint.
+func (c *Constraint) UnmarshalText(text []byte) error {
+	parsed, err := ParseConstraint(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, emitting c as a JSON string in its
+// canonical (non-strict) String() form. Like any encoding/json string
+// value, "<" and ">" come out HTML-escaped (e.g. "\u003e= 1.2.3") unless
+// the caller encodes with a json.Encoder that has SetEscapeHTML(false) -
+// that option only takes effect for the whole encode, since encoding/json
+// re-escapes whatever bytes a type's own MarshalJSON returns. Callers who
+// want the literal operators back can call c.MarshalText directly instead
+// of going through json.Marshal.
+func (c Constraint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, parsing a JSON string with
+// ParseConstraint.
+func (c *Constraint) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+
+	parsed, err := ParseConstraint(text)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// MarshalYAML implements the gopkg.in/yaml.v2-style yaml.Marshaler
+// interface, emitting c as a YAML scalar in its canonical (non-strict)
+// String() form.
+func (c Constraint) MarshalYAML() (interface{}, error) {
+	return c.String(), nil
+}
+
+// UnmarshalYAML implements the gopkg.in/yaml.v2-style yaml.Unmarshaler
+// interface, parsing the scalar with ParseConstraint.
+func (c *Constraint) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var text string
+	if err := unmarshal(&text); err != nil {
+		return err
+	}
+
+	parsed, err := ParseConstraint(text)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, emitting the canonical,
+// comma-joined String() form of c.
+func (c Constraints) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing text with
+// ParseConstraintSet.
+func (c *Constraints) UnmarshalText(text []byte) error {
+	parsed, err := ParseConstraintSet(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, emitting c as a JSON string in its
+// canonical, comma-joined StrictString() form. See Constraint.MarshalJSON
+// for why "<" and ">" come out HTML-escaped.
+func (c Constraints) MarshalJSON() ([]byte, error) {
+	text, err := c.StrictString()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(text)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, parsing a JSON string with
+// ParseConstraintSet.
+func (c *Constraints) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+
+	parsed, err := ParseConstraintSet(text)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// MarshalYAML implements the gopkg.in/yaml.v2-style yaml.Marshaler
+// interface, emitting c as a YAML scalar in its canonical, comma-joined
+// StrictString() form.
+func (c Constraints) MarshalYAML() (interface{}, error) {
+	return c.StrictString()
+}
+
+// UnmarshalYAML implements the gopkg.in/yaml.v2-style yaml.Unmarshaler
+// interface, parsing the scalar with ParseConstraintSet.
+func (c *Constraints) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var text string
+	if err := unmarshal(&text); err != nil {
+		return err
+	}
+
+	parsed, err := ParseConstraintSet(text)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
@@ -0,0 +1,131 @@
+package verlib_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestVersionJSONRoundTrip(t *testing.T) {
+	original := verlib.NewPreReleaseVersion(1, 2, 3, "beta.1")
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+	if string(data) != `"1.2.3-beta.1"` {
+		t.Errorf("got %s, expected %q", data, `"1.2.3-beta.1"`)
+	}
+
+	var roundTripped verlib.Version
+	if err := json.Unmarshal(data, &roundTripped); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if !roundTripped.Equal(original) {
+		t.Errorf("got %s, expected %s", roundTripped.String(), original.String())
+	}
+}
+
+func TestVersionAsStructField(t *testing.T) {
+	type Release struct {
+		Name    string         `json:"name"`
+		Version verlib.Version `json:"version"`
+	}
+
+	original := Release{Name: "widget", Version: verlib.NewVersion(1, 2, 3)}
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+	if string(data) != `{"name":"widget","version":"1.2.3"}` {
+		t.Errorf("got %s", data)
+	}
+
+	var roundTripped Release
+	if err := json.Unmarshal(data, &roundTripped); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if !roundTripped.Version.Equal(original.Version) || roundTripped.Name != original.Name {
+		t.Errorf("got %+v, expected %+v", roundTripped, original)
+	}
+}
+
+func TestVersionPrerelease(t *testing.T) {
+	if verlib.NewVersion(1, 0, 0).Prerelease() {
+		t.Error("expected 1.0.0 to not be a pre-release")
+	}
+	if !verlib.NewPreReleaseVersion(1, 0, 0, "alpha").Prerelease() {
+		t.Error("expected 1.0.0-alpha to be a pre-release")
+	}
+}
+
+func TestConstraintJSONRoundTrip(t *testing.T) {
+	original := verlib.NewConstraint(verlib.GE, verlib.NewPreReleaseVersion(1, 2, 3, "beta.1"))
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+	// encoding/json HTML-escapes "<"/">" in any string value, including the
+	// bytes a custom MarshalJSON returns - there's no opting out of that from
+	// inside Constraint.MarshalJSON itself, only from the caller's Encoder.
+	want := `"\u003e= 1.2.3-beta.1"`
+	if string(data) != want {
+		t.Errorf("got %s, expected %q", data, want)
+	}
+
+	var roundTripped verlib.Constraint
+	if err := json.Unmarshal(data, &roundTripped); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if roundTripped.String() != original.String() {
+		t.Errorf("got %q, expected %q", roundTripped.String(), original.String())
+	}
+}
+
+func TestConstraintsJSONRoundTrip(t *testing.T) {
+	original := verlib.Constraints{
+		verlib.NewConstraint(verlib.GE, verlib.NewPreReleaseVersion(1, 2, 3, "beta.1")),
+		verlib.NewConstraint(verlib.LT, verlib.NewVersion(2, 0, 0)),
+	}
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+	// encoding/json HTML-escapes "<"/">", same as TestConstraintJSONRoundTrip.
+	if !strings.Contains(string(data), `\u003e`) || !strings.Contains(string(data), `\u003c`) {
+		t.Errorf("expected %s to contain HTML-escaped \\u003e and \\u003c operators", data)
+	}
+
+	var roundTripped verlib.Constraints
+	if err := json.Unmarshal(data, &roundTripped); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if roundTripped.String() != original.String() {
+		t.Errorf("got %q, expected %q", roundTripped.String(), original.String())
+	}
+}
+
+func TestConstraintsTextRoundTrip(t *testing.T) {
+	original, err := verlib.ParseConstraintSet(">= 1.0.0, < 2.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, err := original.MarshalText()
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+
+	var roundTripped verlib.Constraints
+	if err := roundTripped.UnmarshalText(text); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if roundTripped.String() != original.String() {
+		t.Errorf("got %q, expected %q", roundTripped.String(), original.String())
+	}
+}
@@ -0,0 +1,253 @@
+package verlib
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Ordering defines a pluggable strategy for comparing two Versions. The
+// package defaults to SemVer precedence (DefaultOrdering), but callers
+// working with ecosystems that don't follow SemVer - such as Arch Linux's
+// pacman, RPM, or Debian packages - can supply an alternative, such as
+// AlpmOrdering, to get correct results from Compare and the *Ordered
+// constraint helpers.
+type Ordering interface {
+	// Compare returns -1 if a sorts before b, 1 if a sorts after b, and 0
+	// if they are equivalent under this Ordering.
+	Compare(a, b Version) int
+}
+
+// semVerOrdering is the default Ordering, matching the existing
+// Less/Equal/Greater semantics of this package.
+type semVerOrdering struct{}
+
+// Compare implements Ordering using the existing SemVer-precedence Less.
+func (semVerOrdering) Compare(a, b Version) int {
+	switch {
+	case a.Less(b):
+		return -1
+	case b.Less(a):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// DefaultOrdering is the SemVer-precedence Ordering used by Version values
+// created without an explicit Ordering.
+var DefaultOrdering Ordering = semVerOrdering{}
+
+// NewVersionWithOrdering creates a Version identically to NewVersion, but
+// records ordering so that Compare (and the *Ordered constraint helpers)
+// use it instead of DefaultOrdering when this Version is involved in a
+// comparison.
+func NewVersionWithOrdering(major, minor, patch uint64, ordering Ordering) Version {
+	v := NewVersion(major, minor, patch)
+	v.ordering = ordering
+	return v
+}
+
+// Ordering returns the Ordering associated with v, or DefaultOrdering if
+// none was set.
+func (v Version) Ordering() Ordering {
+	if v.ordering == nil {
+		return DefaultOrdering
+	}
+	return v.ordering
+}
+
+// Compare returns -1, 0, or 1 depending on whether v sorts before, equal
+// to, or after other, using v's Ordering (or other's, if v has none).
+func (v Version) Compare(other Version) int {
+	if v.ordering == nil && other.ordering != nil {
+		return other.ordering.Compare(v, other)
+	}
+	return v.Ordering().Compare(v, other)
+}
+
+// AlpmOrdering implements the alpm/RPM vercmp comparison rules referenced
+// by Arch Linux's pacman: an optional leading "N:" epoch dominates all
+// other comparisons, the remainder of the version string is split into
+// alternating runs of digits and non-digits, numeric runs compare as
+// integers (ignoring leading zeros), non-numeric runs compare
+// lexicographically byte-by-byte, and a literal "~" segment sorts before
+// the empty string, so "1.0~rc1" orders before "1.0".
+//
+// Compare operates on the String() form of the two Versions, since the
+// epoch/tilde/alpha segments this scheme relies on don't have dedicated
+// fields on Version.
+type AlpmOrdering struct{}
+
+// Compare implements Ordering using CompareAlpm on the String() form of
+// each Version.
+func (AlpmOrdering) Compare(a, b Version) int {
+	return CompareAlpm(a.String(), b.String())
+}
+
+// CompareAlpm compares two raw version strings according to the alpm/RPM
+// vercmp rules described on AlpmOrdering. Unlike AlpmOrdering.Compare, it
+// operates directly on strings, so it is not limited by what Version's
+// major/minor/patch/preRelease/buildMetadata fields can represent - callers
+// dealing with real epoch/tilde version strings (e.g. "1:1.0~rc1-2") should
+// prefer it over round-tripping through Version.
+func CompareAlpm(a, b string) int {
+	aEpoch, aRest := splitEpoch(a)
+	bEpoch, bRest := splitEpoch(b)
+
+	if aEpoch != bEpoch {
+		if aEpoch < bEpoch {
+			return -1
+		}
+		return 1
+	}
+
+	aSegments := splitVercmpSegments(aRest)
+	bSegments := splitVercmpSegments(bRest)
+
+	for i := 0; i < len(aSegments) || i < len(bSegments); i++ {
+		var aSeg, bSeg string
+		if i < len(aSegments) {
+			aSeg = aSegments[i]
+		}
+		if i < len(bSegments) {
+			bSeg = bSegments[i]
+		}
+
+		if c := compareVercmpSegment(aSeg, bSeg); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+// splitEpoch pulls a leading "N:" epoch off a version string, defaulting to
+// epoch 0 when none is present.
+func splitEpoch(version string) (uint64, string) {
+	if idx := strings.Index(version, ":"); idx >= 0 {
+		if epoch, err := strconv.ParseUint(version[:idx], 10, 64); err == nil {
+			return epoch, version[idx+1:]
+		}
+	}
+	return 0, version
+}
+
+// splitVercmpSegments splits a version string (with the epoch already
+// removed) into alternating runs of digits and non-digits, treating each
+// run of digits or non-digits as its own segment, in order.
+func splitVercmpSegments(version string) []string {
+	var segments []string
+
+	runeSlice := []rune(version)
+	start := 0
+	for start < len(runeSlice) {
+		end := start + 1
+		isDigit := isVercmpDigit(runeSlice[start])
+		for end < len(runeSlice) && isVercmpDigit(runeSlice[end]) == isDigit {
+			end++
+		}
+		segments = append(segments, string(runeSlice[start:end]))
+		start = end
+	}
+
+	return segments
+}
+
+func isVercmpDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// compareVercmpSegment compares a single pair of aligned segments. A "~"
+// segment sorts before everything, including the empty string produced
+// when one version has run out of segments.
+func compareVercmpSegment(a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	aTilde := strings.HasPrefix(a, "~")
+	bTilde := strings.HasPrefix(b, "~")
+	switch {
+	case aTilde && bTilde:
+		return strings.Compare(a, b)
+	case aTilde:
+		return -1
+	case bTilde:
+		return 1
+	}
+
+	if a == "" {
+		return -1
+	}
+	if b == "" {
+		return 1
+	}
+
+	aNum, aIsNum := parseVercmpNumber(a)
+	bNum, bIsNum := parseVercmpNumber(b)
+
+	switch {
+	case aIsNum && bIsNum:
+		switch {
+		case aNum < bNum:
+			return -1
+		case aNum > bNum:
+			return 1
+		default:
+			return 0
+		}
+	case aIsNum:
+		// Numeric segments always sort after alphabetic ones in vercmp.
+		return 1
+	case bIsNum:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// parseVercmpNumber reports whether segment is a run of ASCII digits, and
+// if so, its value with leading zeros ignored.
+func parseVercmpNumber(segment string) (uint64, bool) {
+	for _, r := range segment {
+		if !isVercmpDigit(r) {
+			return 0, false
+		}
+	}
+	value, err := strconv.ParseUint(segment, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// SatisfiesOrdered determines whether v satisfies Constraint c using
+// ordering instead of the default SemVer precedence. It mirrors
+// Version.Satisfies exactly, substituting ordering.Compare for the
+// comparison methods used there.
+func (v Version) SatisfiesOrdered(c Constraint, ordering Ordering) bool {
+	cmp := ordering.Compare(v, c.version)
+
+	switch c.operator {
+	case EQ:
+		return cmp == 0
+	case NE:
+		return cmp != 0
+	case GT:
+		return cmp > 0
+	case GE:
+		return cmp >= 0
+	case LT:
+		return cmp < 0
+	case LE:
+		return cmp <= 0
+	case GEPessimistic:
+		return cmp >= 0 && ordering.Compare(v, c.version.IncrementPessimistic()) < 0
+	case Caret:
+		return cmp >= 0 && ordering.Compare(v, c.version.IncrementCaret()) < 0
+	case Tilde:
+		return cmp >= 0 && ordering.Compare(v, c.version.IncrementTilde()) < 0
+	default:
+		return false
+	}
+}
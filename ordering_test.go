@@ -0,0 +1,54 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestCompareAlpm(t *testing.T) {
+	testCases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0", "1.0~rc1", 1},
+		{"1.0", "1.1", -1},
+		{"1:1.0", "2.0", 1},
+		{"1.0a", "1.0b", -1},
+		{"1.0.2", "1.0.10", -1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a+"#"+tc.b, func(t *testing.T) {
+			result := verlib.CompareAlpm(tc.a, tc.b)
+			if result != tc.expected {
+				t.Errorf("CompareAlpm(%q, %q) = %d, expected %d", tc.a, tc.b, result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestVersionCompareUsesOrdering(t *testing.T) {
+	v1 := verlib.NewVersionWithOrdering(1, 0, 0, verlib.AlpmOrdering{})
+	v2 := verlib.NewVersion(1, 1, 0)
+
+	if v1.Compare(v2) >= 0 {
+		t.Errorf("expected 1.0.0 to compare less than 1.1.0, got %d", v1.Compare(v2))
+	}
+
+	if verlib.NewVersion(2, 0, 0).Compare(verlib.NewVersion(1, 0, 0)) <= 0 {
+		t.Error("expected default ordering to report 2.0.0 greater than 1.0.0")
+	}
+}
+
+func TestSatisfiesOrdered(t *testing.T) {
+	v := verlib.NewVersionWithOrdering(1, 0, 0, verlib.AlpmOrdering{})
+	c := verlib.NewConstraint(verlib.LT, verlib.NewVersion(1, 1, 0))
+
+	if !v.SatisfiesOrdered(c, verlib.AlpmOrdering{}) {
+		t.Error("expected 1.0.0 to satisfy < 1.1.0 under AlpmOrdering")
+	}
+}
@@ -178,9 +178,10 @@ func MustParseSemVer(version string) Version {
 //
 // `^` : Matches the start of the line.
 //
-// `(!=|=|>=|>|<=|<|~>)?` : This group matches an optional comparison operator. The comparison operator can be one of
-// the following: "!=" (not equal), "=" (equal), ">=" (greater than or equal to), ">" (greater than), "<=" (less than
-// or equal to), "<" (less than), or "~>" (approximately greater than).
+// `(!=|=|>=|>|<=|<|~>|\^|~)?` : This group matches an optional comparison operator. The comparison operator can be
+// one of the following: "!=" (not equal), "=" (equal), ">=" (greater than or equal to), ">" (greater than), "<="
+// (less than or equal to), "<" (less than), "~>" (approximately greater than), "^" (caret, compatible with), or "~"
+// (tilde, patch/minor-compatible). "~>" is listed before the bare "~" so it is preferred when both could match.
 //
 // `[^\d\n]*` : This group matches zero or more characters that are neither digits nor newlines. This part is intended
 // to allow any optional text before the version number.
@@ -190,30 +191,36 @@ func MustParseSemVer(version string) Version {
 // alphanumeric or symbolic data (like "-alpha", "+20130313144700" in semantic versioning).
 //
 // `$` : Matches the end of the line.
-var constraintRegex = regexp.MustCompile(`^(!=|=|>=|>|<=|<|~>)?[^\d\n]*(\d+\S*)$`)
+var constraintRegex = regexp.MustCompile(`^(!=|=|>=|>|<=|<|~>|\^|~)?[^\d\n]*(\d+\S*)$`)
 
 // strictConstraintRegex is a regular expression used to parse strict version constraints.
 // The regular expression is broken down as follows:
 //
 // `^` : Matches the start of the line.
 //
-// `(P<operator>!=|=|>=|>|<=|<|~>)?` : This named capture group matches an optional comparison operator.
+// `(P<operator>!=|=|>=|>|<=|<|~>|\^|~)?` : This named capture group matches an optional comparison operator.
 // The comparison operator can be one of the following: "!=" (not equal), "=" (equal), ">=" (greater than or equal to),
-// ">" (greater than), "<=" (less than or equal to), "<" (less than), or "~>" (approximately greater than).
+// ">" (greater than), "<=" (less than or equal to), "<" (less than), "~>" (approximately greater than), "^" (caret,
+// compatible with), or "~" (tilde, patch/minor-compatible). "~>" is listed before the bare "~" so it is preferred
+// when both could match.
 //
 // `\s*` : Matches any whitespace character between the operator and the semver.
 //
 // `(?P<semver>` : Named capture group "semver" that matches a semver adhering to the Semantic Versioning specification (SemVer 2.0.0).
 //
 // `$` : Matches the end of the line.
-var strictConstraintRegex = regexp.MustCompile(`^(?P<operator>!=|=|>=|>|<=|<|~>)?\s*(?P<semver>(?:0|[1-9]\d*)\.(?:0|[1-9]\d*)\.(?:0|[1-9]\d*)(?:-(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*)?(?:\+[0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*)?)?$`)
+var strictConstraintRegex = regexp.MustCompile(`^(?P<operator>!=|=|>=|>|<=|<|~>|\^|~)?\s*(?P<semver>(?:0|[1-9]\d*)\.(?:0|[1-9]\d*)\.(?:0|[1-9]\d*)(?:-(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*)?(?:\+[0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*)?)?$`)
 
 // ParseConstraint takes a version constraint string as input and attempts to parse it
 // according to a pre-defined regular expression (constraintRegex).
 // The version constraint string should contain an operator and a version.
 // Returns a populated Constraint struct and nil on successful parsing.
 // If the parsing fails or if the operator is invalid, it returns an empty Constraint struct and an error.
-func ParseConstraint(verConstraint string) (Constraint, error) {
+//
+// opts configures options that only take effect when the Constraint is later
+// checked with Version.SatisfiesWith, such as WithIncludePrerelease; plain
+// Satisfies ignores them. See ConstraintOption for details.
+func ParseConstraint(verConstraint string, opts ...ConstraintOption) (Constraint, error) {
 	verConstraint = strings.TrimSpace(verConstraint)
 	constraintMatches := constraintRegex.FindStringSubmatch(verConstraint)
 	if len(constraintMatches) != 3 {
@@ -226,7 +233,7 @@ func ParseConstraint(verConstraint string) (Constraint, error) {
 	}
 
 	switch parsedOperator {
-	case EQ, NE, GT, GE, LT, LE, GEPessimistic:
+	case EQ, NE, GT, GE, LT, LE, GEPessimistic, Caret, Tilde:
 	default:
 		return Constraint{}, fmt.Errorf("invalid operator %q in constraint %q", parsedOperator, verConstraint)
 	}
@@ -236,9 +243,12 @@ func ParseConstraint(verConstraint string) (Constraint, error) {
 		return Constraint{}, fmt.Errorf("failed to parse version in constraint: %w", err)
 	}
 
+	options := resolveConstraintOptions(opts)
+
 	return Constraint{
-		operator: parsedOperator,
-		version:  version,
+		operator:          parsedOperator,
+		version:           version,
+		includePrerelease: options.includePrerelease,
 	}, nil
 }
 
@@ -292,7 +302,7 @@ func ParseStrictConstraint(verConstraint string) (Constraint, error) {
 	}
 
 	switch parsedOperator {
-	case EQ, NE, GT, GE, LT, LE, GEPessimistic:
+	case EQ, NE, GT, GE, LT, LE, GEPessimistic, Caret, Tilde:
 	default:
 		return Constraint{}, fmt.Errorf("invalid operator %q in strict constraint %q", parsedOperator, verConstraint)
 	}
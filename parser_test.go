@@ -291,6 +291,18 @@ func TestParseConstraint(t *testing.T) {
 		{input: "~>1.2", expectedOutput: verlib.NewConstraint(verlib.GEPessimistic, verlib.NewVersion(1, 2, 0)), expectError: false},
 		{input: "~>1.2.3", expectedOutput: verlib.NewConstraint(verlib.GEPessimistic, verlib.NewVersion(1, 2, 3)), expectError: false},
 		{input: "~>1.2.3-alpha", expectedOutput: verlib.NewConstraint(verlib.GEPessimistic, verlib.NewPreReleaseVersion(1, 2, 3, "alpha")), expectError: false},
+
+		// Test cases for "^" operator
+		{input: "^1", expectedOutput: verlib.NewConstraint(verlib.Caret, verlib.NewVersion(1, 0, 0)), expectError: false},
+		{input: "^1.2", expectedOutput: verlib.NewConstraint(verlib.Caret, verlib.NewVersion(1, 2, 0)), expectError: false},
+		{input: "^1.2.3", expectedOutput: verlib.NewConstraint(verlib.Caret, verlib.NewVersion(1, 2, 3)), expectError: false},
+		{input: "^1.2.3-alpha", expectedOutput: verlib.NewConstraint(verlib.Caret, verlib.NewPreReleaseVersion(1, 2, 3, "alpha")), expectError: false},
+
+		// Test cases for "~" operator
+		{input: "~1", expectedOutput: verlib.NewConstraint(verlib.Tilde, verlib.NewVersion(1, 0, 0)), expectError: false},
+		{input: "~1.2", expectedOutput: verlib.NewConstraint(verlib.Tilde, verlib.NewVersion(1, 2, 0)), expectError: false},
+		{input: "~1.2.3", expectedOutput: verlib.NewConstraint(verlib.Tilde, verlib.NewVersion(1, 2, 3)), expectError: false},
+		{input: "~1.2.3-alpha", expectedOutput: verlib.NewConstraint(verlib.Tilde, verlib.NewPreReleaseVersion(1, 2, 3, "alpha")), expectError: false},
 	}
 
 	for _, test := range testCases {
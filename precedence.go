@@ -0,0 +1,119 @@
+package verlib
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PreReleaseIdentifiers returns v's pre-release label split into its
+// dot-separated identifiers, the units SemVer 2.0.0 §11 precedence
+// compares individually (e.g. "alpha.2" becomes ["alpha", "2"]). If v has
+// no pre-release label, it returns nil.
+func (v Version) PreReleaseIdentifiers() []string {
+	if v.preRelease == "" {
+		return nil
+	}
+	return strings.Split(v.preRelease, ".")
+}
+
+// ComparePrecedence compares v and other's SemVer precedence - major,
+// minor, patch, Extra components, and pre-release identifiers, in that
+// order - ignoring build metadata entirely, per SemVer 2.0.0 §11. It
+// returns -1 if v has lower precedence than other, 0 if they are equal,
+// and 1 if v has higher precedence.
+func (v Version) ComparePrecedence(other Version) int {
+	switch {
+	case v.Major() != other.Major():
+		if v.Major() < other.Major() {
+			return -1
+		}
+		return 1
+	case v.Minor() != other.Minor():
+		if v.Minor() < other.Minor() {
+			return -1
+		}
+		return 1
+	case v.Patch() != other.Patch():
+		if v.Patch() < other.Patch() {
+			return -1
+		}
+		return 1
+	}
+
+	if c := compareExtra(v.Extra, other.Extra); c != 0 {
+		return c
+	}
+
+	switch {
+	case v.preRelease == "" && other.preRelease != "":
+		return 1
+	case v.preRelease != "" && other.preRelease == "":
+		return -1
+	case v.preRelease == other.preRelease:
+		return 0
+	}
+
+	return comparePreReleaseIdentifiers(v.preRelease, other.preRelease)
+}
+
+// comparePreReleaseIdentifiers implements SemVer 2.0.0 §11's precedence
+// rule for two pre-release labels: split both on ".", compare identifiers
+// left-to-right (numeric identifiers compare numerically and always rank
+// lower than alphanumeric ones, alphanumeric identifiers compare
+// lexically in ASCII order), and when every compared identifier is equal,
+// the label with fewer identifiers has lower precedence.
+func comparePreReleaseIdentifiers(a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		ai, bi := aParts[i], bParts[i]
+		if ai == bi {
+			continue
+		}
+
+		aNum, aIsNumeric := parseNumericIdentifier(ai)
+		bNum, bIsNumeric := parseNumericIdentifier(bi)
+
+		switch {
+		case aIsNumeric && bIsNumeric:
+			if aNum < bNum {
+				return -1
+			}
+			return 1
+		case aIsNumeric && !bIsNumeric:
+			return -1
+		case !aIsNumeric && bIsNumeric:
+			return 1
+		case ai < bi:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	return compareInt(len(aParts), len(bParts))
+}
+
+// parseNumericIdentifier reports whether s is a SemVer numeric pre-release
+// identifier (one or more digits) and, if so, its value.
+func parseNumericIdentifier(s string) (uint64, bool) {
+	if s == "" {
+		return 0, false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0, false
+		}
+	}
+
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,90 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestPreReleaseIdentifiers(t *testing.T) {
+	v := verlib.NewPreReleaseVersion(1, 0, 0, "alpha.2.beta")
+
+	identifiers := v.PreReleaseIdentifiers()
+	expected := []string{"alpha", "2", "beta"}
+	if len(identifiers) != len(expected) {
+		t.Fatalf("got %v, expected %v", identifiers, expected)
+	}
+	for i := range expected {
+		if identifiers[i] != expected[i] {
+			t.Errorf("got %v, expected %v", identifiers, expected)
+		}
+	}
+
+	if verlib.NewVersion(1, 0, 0).PreReleaseIdentifiers() != nil {
+		t.Error("expected no pre-release to report nil identifiers")
+	}
+}
+
+func TestNumericPreReleaseIdentifiersCompareNumerically(t *testing.T) {
+	older := verlib.NewPreReleaseVersion(1, 0, 0, "alpha.2")
+	newer := verlib.NewPreReleaseVersion(1, 0, 0, "alpha.10")
+
+	if !older.Less(newer) {
+		t.Error("expected 1.0.0-alpha.2 to sort before 1.0.0-alpha.10 numerically, not lexically")
+	}
+	if newer.Less(older) {
+		t.Error("expected 1.0.0-alpha.10 to not sort before 1.0.0-alpha.2")
+	}
+}
+
+func TestShorterPreReleaseSortsLower(t *testing.T) {
+	shorter := verlib.NewPreReleaseVersion(1, 0, 0, "alpha")
+	longer := verlib.NewPreReleaseVersion(1, 0, 0, "alpha.1")
+
+	if !shorter.Less(longer) {
+		t.Error("expected 1.0.0-alpha to sort before 1.0.0-alpha.1")
+	}
+}
+
+func TestNumericIdentifiersRankBelowAlphanumeric(t *testing.T) {
+	numeric := verlib.NewPreReleaseVersion(1, 0, 0, "1")
+	alpha := verlib.NewPreReleaseVersion(1, 0, 0, "alpha")
+
+	if !numeric.Less(alpha) {
+		t.Error("expected 1.0.0-1 to sort before 1.0.0-alpha")
+	}
+}
+
+func TestComparePrecedenceMatchesSemVerSpecOrdering(t *testing.T) {
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+	}
+
+	for i := 0; i < len(ordered)-1; i++ {
+		lower := verlib.MustParseVersion(ordered[i])
+		higher := verlib.MustParseVersion(ordered[i+1])
+
+		if lower.ComparePrecedence(higher) != -1 {
+			t.Errorf("expected %s to have lower precedence than %s", ordered[i], ordered[i+1])
+		}
+		if higher.ComparePrecedence(lower) != 1 {
+			t.Errorf("expected %s to have higher precedence than %s", ordered[i+1], ordered[i])
+		}
+	}
+}
+
+func TestComparePrecedenceIgnoresBuildMetadata(t *testing.T) {
+	a := verlib.NewPreReleaseVersion(1, 0, 0, "alpha").SetBuildMetadata("001")
+	b := verlib.NewPreReleaseVersion(1, 0, 0, "alpha").SetBuildMetadata("002")
+
+	if a.ComparePrecedence(b) != 0 {
+		t.Error("expected build metadata to be ignored by ComparePrecedence")
+	}
+}
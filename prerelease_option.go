@@ -0,0 +1,55 @@
+package verlib
+
+// constraintOptions holds the resolved state of every ConstraintOption
+// applied to a Constraint or to a single SatisfiesWith call.
+type constraintOptions struct {
+	includePrerelease bool
+}
+
+// ConstraintOption configures optional, opt-in matching behavior for a
+// Constraint, such as WithIncludePrerelease. Pass one or more to
+// ParseConstraint or Version.SatisfiesWith.
+type ConstraintOption func(*constraintOptions)
+
+// WithIncludePrerelease controls whether a pre-release Version may satisfy
+// a Constraint whose boundary version carries no pre-release tag of its
+// own. Most ecosystems (npm, Cargo) exclude pre-releases from matching a
+// plain range unless the range itself targets the same major.minor.patch
+// pre-release line; passing true disables that restriction so pre-release
+// versions are compared using ordinary precedence rules instead.
+func WithIncludePrerelease(include bool) ConstraintOption {
+	return func(o *constraintOptions) {
+		o.includePrerelease = include
+	}
+}
+
+// resolveConstraintOptions applies opts in order over the zero value.
+func resolveConstraintOptions(opts []ConstraintOption) constraintOptions {
+	var options constraintOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// SatisfiesWith determines whether v satisfies Constraint c, like
+// Satisfies, but additionally applies the npm/Masterminds pre-release
+// matching convention: if v carries a pre-release tag, v only satisfies c
+// when c's boundary version also carries a pre-release tag and shares v's
+// major.minor.patch - unless WithIncludePrerelease(true) is passed here or
+// was set on c via ParseConstraint, in which case v is compared using
+// ordinary precedence regardless of pre-release tags, identical to
+// Satisfies.
+func (v Version) SatisfiesWith(c Constraint, opts ...ConstraintOption) bool {
+	options := resolveConstraintOptions(opts)
+	includePrerelease := options.includePrerelease || c.includePrerelease
+
+	if v.Prerelease() && !includePrerelease {
+		sameLine := v.Major() == c.version.Major() && v.Minor() == c.version.Minor() && v.Patch() == c.version.Patch()
+		if !c.version.Prerelease() || !sameLine {
+			return false
+		}
+	}
+
+	return v.Satisfies(c)
+}
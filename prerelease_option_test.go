@@ -0,0 +1,52 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestSatisfiesWithExcludesPrerelease(t *testing.T) {
+	c := verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0))
+	v := verlib.NewPreReleaseVersion(2, 0, 0, "beta")
+
+	if v.SatisfiesWith(c) {
+		t.Error("expected 2.0.0-beta to not satisfy >= 1.0.0 under default prerelease rules")
+	}
+	if v.Satisfies(c) != true {
+		t.Error("expected plain Satisfies to remain unaffected by prerelease filtering")
+	}
+}
+
+func TestSatisfiesWithIncludePrerelease(t *testing.T) {
+	c := verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0))
+	v := verlib.NewPreReleaseVersion(2, 0, 0, "beta")
+
+	if !v.SatisfiesWith(c, verlib.WithIncludePrerelease(true)) {
+		t.Error("expected 2.0.0-beta to satisfy >= 1.0.0 when prereleases are included")
+	}
+}
+
+func TestSatisfiesWithMatchingPrereleaseLine(t *testing.T) {
+	c, err := verlib.ParseConstraint(">= 2.0.0-alpha")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	v := verlib.NewPreReleaseVersion(2, 0, 0, "beta")
+
+	if !v.SatisfiesWith(c) {
+		t.Error("expected 2.0.0-beta to satisfy >= 2.0.0-alpha since both share the 2.0.0 line")
+	}
+}
+
+func TestParseConstraintWithIncludePrereleaseOption(t *testing.T) {
+	c, err := verlib.ParseConstraint(">= 1.0.0", verlib.WithIncludePrerelease(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	v := verlib.NewPreReleaseVersion(2, 0, 0, "beta")
+	if !v.SatisfiesWith(c) {
+		t.Error("expected the constraint's own WithIncludePrerelease(true) option to apply")
+	}
+}
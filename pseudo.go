@@ -0,0 +1,46 @@
+package verlib
+
+import (
+	"regexp"
+	"time"
+)
+
+// pseudoVersionRegex matches the pre-release shape Go modules use for a
+// version synthesized for an untagged commit: a 14-digit UTC timestamp
+// (yyyymmddhhmmss) followed by a 12-character lowercase hex commit prefix,
+// e.g. the "20200101120000-abcdef123456" in
+// "v0.0.0-20200101120000-abcdef123456".
+var pseudoVersionRegex = regexp.MustCompile(`^(\d{14})-([0-9a-f]{12})$`)
+
+// IsPseudo reports whether v's pre-release label has the shape of a Go
+// module pseudo-version.
+func (v Version) IsPseudo() bool {
+	return pseudoVersionRegex.MatchString(v.preRelease)
+}
+
+// PseudoTimestamp returns the commit timestamp, in UTC, embedded in v's
+// pseudo-version pre-release label. If v is not a pseudo-version, it
+// returns the zero time.Time.
+func (v Version) PseudoTimestamp() time.Time {
+	matches := pseudoVersionRegex.FindStringSubmatch(v.preRelease)
+	if matches == nil {
+		return time.Time{}
+	}
+
+	t, err := time.Parse("20060102150405", matches[1])
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// PseudoRevision returns the 12-character commit hash prefix embedded in
+// v's pseudo-version pre-release label. If v is not a pseudo-version, it
+// returns an empty string.
+func (v Version) PseudoRevision() string {
+	matches := pseudoVersionRegex.FindStringSubmatch(v.preRelease)
+	if matches == nil {
+		return ""
+	}
+	return matches[2]
+}
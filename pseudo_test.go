@@ -0,0 +1,56 @@
+package verlib_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestIsPseudo(t *testing.T) {
+	v := verlib.MustParseVersion("v0.0.0-20200101120000-abcdef123456")
+	if !v.IsPseudo() {
+		t.Error("expected a Go module pseudo-version to report IsPseudo")
+	}
+
+	if verlib.MustParseVersion("v1.2.3-alpha").IsPseudo() {
+		t.Error("expected a plain tagged prerelease to not report IsPseudo")
+	}
+}
+
+func TestPseudoTimestamp(t *testing.T) {
+	v := verlib.MustParseVersion("v0.0.0-20200101120000-abcdef123456")
+
+	expected := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	if !v.PseudoTimestamp().Equal(expected) {
+		t.Errorf("got %v, expected %v", v.PseudoTimestamp(), expected)
+	}
+
+	if !verlib.MustParseVersion("v1.2.3-alpha").PseudoTimestamp().IsZero() {
+		t.Error("expected a non-pseudo version to report a zero PseudoTimestamp")
+	}
+}
+
+func TestPseudoRevision(t *testing.T) {
+	v := verlib.MustParseVersion("v0.0.0-20200101120000-abcdef123456")
+	if v.PseudoRevision() != "abcdef123456" {
+		t.Errorf("got %q, expected %q", v.PseudoRevision(), "abcdef123456")
+	}
+
+	if verlib.MustParseVersion("v1.2.3-alpha").PseudoRevision() != "" {
+		t.Error("expected a non-pseudo version to report an empty PseudoRevision")
+	}
+}
+
+func TestPseudoVersionOrdering(t *testing.T) {
+	alpha := verlib.MustParseVersion("v0.0.0-alpha")
+	pseudo := verlib.MustParseVersion("v0.0.0-20200101120000-abcdef123456")
+	release := verlib.MustParseVersion("v0.0.0")
+
+	if !alpha.Less(pseudo) {
+		t.Error("expected a tagged prerelease to sort below a pseudo-version of the same base")
+	}
+	if !pseudo.Less(release) {
+		t.Error("expected a pseudo-version to sort below the release it precedes")
+	}
+}
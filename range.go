@@ -0,0 +1,338 @@
+package verlib
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Compat selects which ecosystem's conventions apply when interpreting range
+// operators (caret, tilde, wildcards). npm and Cargo's caret ranges agree on
+// `^0.2.3`'s leading-nonzero-component rule (both allow `>=0.2.3, <0.3.0`);
+// Compat exists for the cases that do differ, such as CompatRuby's tilde.
+type Compat int
+
+const (
+	// CompatNPM interprets caret/tilde ranges the way node-semver does:
+	// `^0.2.3` allows `>=0.2.3, <0.3.0` (the leading nonzero component is
+	// pinned, wherever it falls).
+	CompatNPM Compat = iota
+	// CompatCargo interprets caret ranges the way Cargo's own caret
+	// requirements do. For caret ranges this matches CompatNPM exactly -
+	// both pin the leading nonzero component - so it's provided for
+	// callers that want to say so explicitly rather than for any
+	// behavioral difference.
+	CompatCargo
+	// CompatRuby interprets tilde ranges the way RubyGems' `~>` operator
+	// does, matching the pessimistic operator already supported by this
+	// package.
+	CompatRuby
+)
+
+// rangeComponentRegex matches a single dot-separated version component that
+// may be a wildcard (`x`, `X`, or `*`) in addition to a plain number.
+var rangeComponentRegex = regexp.MustCompile(`^(\d+|[xX*])$`)
+
+// ParseConstraintExpression parses a constraint string that may combine
+// caret (`^`), tilde (`~`), hyphen (`A - B`), and wildcard (`1.2.x`, `1.*`)
+// ranges, with `||` separating OR-alternatives. Each alternative is split on
+// commas and parsed as an AND-group of Constraints, the same way
+// ParseConstraintSet does for plain constraints.
+//
+// compat selects the ecosystem semantics used to expand `^` and `~` ranges;
+// see Compat for details.
+func ParseConstraintExpression(expression string, compat Compat) (ConstraintExpression, error) {
+	var alternatives ConstraintExpression
+
+	for _, rawAlternative := range strings.Split(expression, "||") {
+		rawAlternative = strings.TrimSpace(rawAlternative)
+		if rawAlternative == "" {
+			return nil, fmt.Errorf("empty alternative in constraint expression %q", expression)
+		}
+
+		group, err := parseRangeGroup(rawAlternative, compat)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse alternative %q: %w", rawAlternative, err)
+		}
+
+		alternatives = append(alternatives, group)
+	}
+
+	return alternatives, nil
+}
+
+// parseRangeGroup parses a single, comma-free-of-OR AND-group, expanding any
+// hyphen range first (since a hyphen range spans the whole group) and then
+// expanding caret/tilde/wildcard constraints term by term.
+func parseRangeGroup(group string, compat Compat) (Constraints, error) {
+	if hyphen, ok := splitHyphenRange(group); ok {
+		return hyphenRangeToConstraints(hyphen[0], hyphen[1])
+	}
+
+	var result Constraints
+
+	for _, term := range strings.Split(group, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		termConstraints, err := parseRangeTerm(term, compat)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, termConstraints...)
+	}
+
+	return result, nil
+}
+
+// splitHyphenRange splits "A - B" into its two operands. It requires spaces
+// around the hyphen so it isn't confused with a pre-release hyphen.
+func splitHyphenRange(s string) ([2]string, bool) {
+	parts := strings.SplitN(s, " - ", 2)
+	if len(parts) != 2 {
+		return [2]string{}, false
+	}
+	return [2]string{strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])}, true
+}
+
+// hyphenRangeToConstraints converts a hyphen range "A - B" into the
+// equivalent inclusive `>=A, <=B` Constraints.
+func hyphenRangeToConstraints(lower, upper string) (Constraints, error) {
+	lowerVersion, err := ParseVersion(lower)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse lower bound of hyphen range: %w", err)
+	}
+
+	upperVersion, err := ParseVersion(upper)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse upper bound of hyphen range: %w", err)
+	}
+
+	return Constraints{
+		NewConstraint(GE, lowerVersion),
+		NewConstraint(LE, upperVersion),
+	}, nil
+}
+
+// parseRangeTerm parses a single constraint term, which may carry a caret,
+// tilde, or wildcard prefix/shape, into the equivalent Constraints.
+func parseRangeTerm(term string, compat Compat) (Constraints, error) {
+	switch {
+	case strings.HasPrefix(term, "^"):
+		return caretToConstraints(strings.TrimSpace(term[1:]), compat)
+	case strings.HasPrefix(term, "~") && !strings.HasPrefix(term, "~>"):
+		return tildeToConstraints(strings.TrimSpace(term[1:]), compat)
+	case isWildcardTerm(term):
+		return wildcardToConstraints(term)
+	default:
+		constraint, err := ParseConstraint(term)
+		if err != nil {
+			return nil, err
+		}
+		return Constraints{constraint}, nil
+	}
+}
+
+// isWildcardTerm reports whether term contains a bare wildcard component
+// (`x`, `X`, or `*`) or is the lone `*` wildcard.
+func isWildcardTerm(term string) bool {
+	if term == "*" {
+		return true
+	}
+	for _, component := range strings.Split(term, ".") {
+		if component == "x" || component == "X" || component == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// wildcardComponents parses up to three dot-separated components, returning
+// the numeric value of each defined component and the index of the first
+// wildcard (or len(components) if none is present).
+func wildcardComponents(term string) ([]uint64, int, error) {
+	rawComponents := strings.SplitN(term, ".", 3)
+
+	var numeric []uint64
+	for i, raw := range rawComponents {
+		if !rangeComponentRegex.MatchString(raw) {
+			return nil, 0, fmt.Errorf("invalid wildcard component %q in %q", raw, term)
+		}
+		if raw == "x" || raw == "X" || raw == "*" {
+			return numeric, i, nil
+		}
+
+		value, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid numeric component %q in %q: %w", raw, term, err)
+		}
+		numeric = append(numeric, value)
+	}
+
+	return numeric, len(rawComponents), nil
+}
+
+// ParseWildcardConstraint parses a single constraint term that may be a
+// plain semver constraint (anything ParseConstraint already accepts) or an
+// x-range using `X`, `x`, or `*` in the major, minor, or patch position
+// (`1.2.x`, `1.x`, `*`). X-ranges are rewritten into the equivalent bounded
+// `>=, <` pair before parsing, so both forms are returned as the same
+// Constraints (AND set) shape, giving callers a single entry point that
+// covers both styles.
+func ParseWildcardConstraint(term string) (Constraints, error) {
+	term = strings.TrimSpace(term)
+	if isWildcardTerm(term) {
+		return wildcardToConstraints(term)
+	}
+
+	constraint, err := ParseConstraint(term)
+	if err != nil {
+		return nil, err
+	}
+	return Constraints{constraint}, nil
+}
+
+// wildcardToConstraints expands an x-range such as `1.2.x`, `1.x`, or `*`
+// into the equivalent `>=, <` pair of Constraints.
+func wildcardToConstraints(term string) (Constraints, error) {
+	components, wildcardIndex, err := wildcardComponents(term)
+	if err != nil {
+		return nil, err
+	}
+
+	if wildcardIndex == 0 {
+		return Constraints{NewConstraint(GE, NewVersion(0, 0, 0))}, nil
+	}
+
+	lower := componentsToVersion(components)
+	upper := bumpComponent(components, wildcardIndex-1)
+
+	return Constraints{
+		NewConstraint(GE, lower),
+		NewConstraint(LT, upper),
+	}, nil
+}
+
+// caretToConstraints expands a caret range (`^1.2.3`) into the equivalent
+// `>=, <` pair, honoring the leading-nonzero-component rule: the first
+// nonzero component found (scanning major, minor, then patch) is the one
+// that may not change. npm and Cargo agree on this rule, so compat does not
+// currently affect the result; it is accepted for symmetry with
+// parseRangeTerm's other callers and in case a real divergence surfaces.
+func caretToConstraints(term string, _ Compat) (Constraints, error) {
+	version, err := ParseVersion(term)
+	if err != nil {
+		return nil, fmt.Errorf("invalid caret range %q: %w", term, err)
+	}
+
+	components := []uint64{version.Major(), version.Minor(), version.Patch()}
+
+	bumpIndex := 0
+	switch {
+	case components[0] != 0:
+		bumpIndex = 0
+	case components[1] != 0:
+		bumpIndex = 1
+	default:
+		bumpIndex = 2
+	}
+
+	upper := bumpComponent(components, bumpIndex)
+
+	return Constraints{
+		NewConstraint(GE, version),
+		NewConstraint(LT, upper),
+	}, nil
+}
+
+// tildeToConstraints expands a tilde range (`~1.2.3`, `~1.2`, `~1`) into the
+// equivalent `>=, <` pair: patch-level changes are allowed when a minor
+// version is specified, otherwise minor-level changes are allowed. Under
+// CompatRuby the upper bound is instead the one `~>` uses (see
+// Version.IncrementPessimistic), so `~1.2` allows up to, but not including,
+// 2.0.0.
+func tildeToConstraints(term string, compat Compat) (Constraints, error) {
+	if compat == CompatRuby {
+		version, err := ParseVersion(term)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tilde range %q: %w", term, err)
+		}
+		return Constraints{
+			NewConstraint(GE, version),
+			NewConstraint(LT, version.IncrementPessimistic()),
+		}, nil
+	}
+
+	components, wildcardIndex, err := wildcardComponents(term)
+	if err != nil {
+		return nil, err
+	}
+	if wildcardIndex == 0 {
+		return nil, fmt.Errorf("invalid tilde range %q", term)
+	}
+
+	lower := componentsToVersion(components)
+
+	bumpIndex := 1
+	if len(components) >= 2 {
+		bumpIndex = 1
+	} else {
+		bumpIndex = 0
+	}
+
+	upper := bumpComponent(components, bumpIndex)
+
+	return Constraints{
+		NewConstraint(GE, lower),
+		NewConstraint(LT, upper),
+	}, nil
+}
+
+// componentsToVersion builds a Version from up to three numeric components,
+// treating any components beyond the provided slice as zero.
+func componentsToVersion(components []uint64) Version {
+	var major, minor, patch uint64
+	if len(components) > 0 {
+		major = components[0]
+	}
+	if len(components) > 1 {
+		minor = components[1]
+	}
+	if len(components) > 2 {
+		patch = components[2]
+	}
+	return NewVersion(major, minor, patch)
+}
+
+// bumpComponent increments the component at index (0=major, 1=minor,
+// 2=patch) and zeroes every component to its right, returning the result as
+// a Version. It is used to compute the exclusive upper bound of a range.
+func bumpComponent(components []uint64, index int) Version {
+	major, minor, patch := uint64(0), uint64(0), uint64(0)
+	if len(components) > 0 {
+		major = components[0]
+	}
+	if len(components) > 1 {
+		minor = components[1]
+	}
+	if len(components) > 2 {
+		patch = components[2]
+	}
+
+	switch index {
+	case 0:
+		major++
+		minor, patch = 0, 0
+	case 1:
+		minor++
+		patch = 0
+	default:
+		patch++
+	}
+
+	return NewVersion(major, minor, patch)
+}
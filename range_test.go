@@ -0,0 +1,158 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestParseConstraintExpressionRanges(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		compat     verlib.Compat
+		satisfies  []verlib.Version
+		violates   []verlib.Version
+	}{
+		{
+			name:       "caret npm",
+			expression: "^1.2.3",
+			compat:     verlib.CompatNPM,
+			satisfies:  []verlib.Version{verlib.NewVersion(1, 2, 3), verlib.NewVersion(1, 9, 0)},
+			violates:   []verlib.Version{verlib.NewVersion(2, 0, 0), verlib.NewVersion(1, 2, 2)},
+		},
+		{
+			name:       "caret npm leading zero minor",
+			expression: "^0.2.3",
+			compat:     verlib.CompatNPM,
+			satisfies:  []verlib.Version{verlib.NewVersion(0, 2, 3), verlib.NewVersion(0, 2, 9)},
+			violates:   []verlib.Version{verlib.NewVersion(0, 3, 0)},
+		},
+		{
+			name:       "caret cargo leading zero minor matches npm",
+			expression: "^0.2.3",
+			compat:     verlib.CompatCargo,
+			satisfies:  []verlib.Version{verlib.NewVersion(0, 2, 3), verlib.NewVersion(0, 2, 9)},
+			violates:   []verlib.Version{verlib.NewVersion(0, 3, 0)},
+		},
+		{
+			name:       "tilde",
+			expression: "~1.2.3",
+			satisfies:  []verlib.Version{verlib.NewVersion(1, 2, 3), verlib.NewVersion(1, 2, 9)},
+			violates:   []verlib.Version{verlib.NewVersion(1, 3, 0)},
+		},
+		{
+			name:       "wildcard minor",
+			expression: "1.2.x",
+			satisfies:  []verlib.Version{verlib.NewVersion(1, 2, 0), verlib.NewVersion(1, 2, 9)},
+			violates:   []verlib.Version{verlib.NewVersion(1, 3, 0)},
+		},
+		{
+			name:       "hyphen range",
+			expression: "1.2.3 - 2.3.4",
+			satisfies:  []verlib.Version{verlib.NewVersion(1, 2, 3), verlib.NewVersion(2, 3, 4)},
+			violates:   []verlib.Version{verlib.NewVersion(2, 3, 5)},
+		},
+		{
+			name:       "disjunction",
+			expression: "1.2.3 || ^2.0.0",
+			satisfies:  []verlib.Version{verlib.NewVersion(1, 2, 3), verlib.NewVersion(2, 5, 0)},
+			violates:   []verlib.Version{verlib.NewVersion(1, 2, 4)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := verlib.ParseConstraintExpression(tc.expression, tc.compat)
+			if err != nil {
+				t.Fatalf("failed to parse %q: %v", tc.expression, err)
+			}
+
+			for _, v := range tc.satisfies {
+				if !expr.Satisfies(v) {
+					t.Errorf("expected %s to satisfy %q", v.String(), tc.expression)
+				}
+			}
+			for _, v := range tc.violates {
+				if expr.Satisfies(v) {
+					t.Errorf("expected %s to violate %q", v.String(), tc.expression)
+				}
+			}
+		})
+	}
+}
+
+func TestConstraintExpressionContradicts(t *testing.T) {
+	expr, err := verlib.ParseConstraintExpression(">= 2.0.0, < 1.0.0 || >= 3.0.0", verlib.CompatNPM)
+	if err != nil {
+		t.Fatalf("failed to parse expression: %v", err)
+	}
+
+	if err := expr.Contradicts(); err != nil {
+		t.Errorf("expected no contradiction since one alternative is satisfiable, got %v", err)
+	}
+
+	allContradictory, err := verlib.ParseConstraintExpression(">= 2.0.0, < 1.0.0 || >= 5.0.0, < 4.0.0", verlib.CompatNPM)
+	if err != nil {
+		t.Fatalf("failed to parse expression: %v", err)
+	}
+
+	if err := allContradictory.Contradicts(); err == nil {
+		t.Error("expected contradiction when every alternative is unsatisfiable")
+	}
+}
+
+func TestParseWildcardConstraint(t *testing.T) {
+	testCases := []struct {
+		name      string
+		term      string
+		satisfies []verlib.Version
+		violates  []verlib.Version
+	}{
+		{
+			name:      "minor wildcard",
+			term:      "1.2.x",
+			satisfies: []verlib.Version{verlib.NewVersion(1, 2, 0), verlib.NewVersion(1, 2, 9)},
+			violates:  []verlib.Version{verlib.NewVersion(1, 3, 0)},
+		},
+		{
+			name:      "major wildcard",
+			term:      "1.*",
+			satisfies: []verlib.Version{verlib.NewVersion(1, 0, 0), verlib.NewVersion(1, 9, 9)},
+			violates:  []verlib.Version{verlib.NewVersion(2, 0, 0)},
+		},
+		{
+			name:      "lone wildcard",
+			term:      "*",
+			satisfies: []verlib.Version{verlib.NewVersion(0, 0, 0), verlib.NewVersion(9, 9, 9)},
+		},
+		{
+			name:      "plain semver falls through unchanged",
+			term:      ">=1.2.3",
+			satisfies: []verlib.Version{verlib.NewVersion(1, 2, 3)},
+			violates:  []verlib.Version{verlib.NewVersion(1, 2, 2)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			constraints, err := verlib.ParseWildcardConstraint(tc.term)
+			if err != nil {
+				t.Fatalf("failed to parse %q: %v", tc.term, err)
+			}
+
+			for _, v := range tc.satisfies {
+				if v.SatisfiesAll(constraints) {
+					continue
+				}
+				t.Errorf("expected %s to satisfy %q", v.String(), tc.term)
+			}
+			for _, v := range tc.violates {
+				if !v.SatisfiesAll(constraints) {
+					continue
+				}
+				t.Errorf("expected %s to violate %q", v.String(), tc.term)
+			}
+		})
+	}
+}
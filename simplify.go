@@ -0,0 +1,258 @@
+package verlib
+
+import (
+	"errors"
+	"fmt"
+)
+
+// bound represents one side of an interval derived from a Constraints set:
+// either a lower bound (GE/GT) or an upper bound (LE/LT).
+type bound struct {
+	operator Operator
+	version  Version
+}
+
+// tighterLower returns whichever of a and b excludes more versions at the
+// low end of the range: the one with the larger version, or on a tie, the
+// exclusive (GT) one over the inclusive (GE) one.
+func tighterLower(a, b bound) bound {
+	if a.version.Equal(b.version) {
+		if a.operator == GT || b.operator == GT {
+			return bound{operator: GT, version: a.version}
+		}
+		return bound{operator: GE, version: a.version}
+	}
+	if a.version.Greater(b.version) {
+		return a
+	}
+	return b
+}
+
+// tighterUpper returns whichever of a and b excludes more versions at the
+// high end of the range: the one with the smaller version, or on a tie, the
+// exclusive (LT) one over the inclusive (LE) one.
+func tighterUpper(a, b bound) bound {
+	if a.version.Equal(b.version) {
+		if a.operator == LT || b.operator == LT {
+			return bound{operator: LT, version: a.version}
+		}
+		return bound{operator: LE, version: a.version}
+	}
+	if a.version.Less(b.version) {
+		return a
+	}
+	return b
+}
+
+// Simplify returns a minimal Constraints set equivalent to c: overlapping
+// same-direction bounds are intersected down to the tightest one, `~>`, `^`
+// and `~` constraints are expanded into their equivalent `>=`/`<` pair before
+// intersecting, duplicate `=` constraints are merged, and `!=` constraints
+// already excluded by the resulting bounds are dropped. If c collapses to a
+// single exact version, Constraints{EQ version} is returned. If no version
+// can satisfy every constraint, Simplify returns a ContradictionErr.
+func (c Constraints) Simplify() (Constraints, error) {
+	var (
+		lower, upper *bound
+		eq           *Constraint
+		notEquals    []Constraint
+	)
+
+	for _, constraint := range c {
+		switch constraint.operator {
+		case EQ:
+			if eq != nil && !eq.version.Equal(constraint.version) {
+				return nil, ContradictionErr{c1: *eq, c2: constraint}
+			}
+			eqCopy := constraint
+			eq = &eqCopy
+		case NE:
+			notEquals = append(notEquals, constraint)
+		case GE, GT:
+			b := bound{operator: constraint.operator, version: constraint.version}
+			if lower == nil {
+				lower = &b
+			} else {
+				tightened := tighterLower(*lower, b)
+				lower = &tightened
+			}
+		case LE, LT:
+			b := bound{operator: constraint.operator, version: constraint.version}
+			if upper == nil {
+				upper = &b
+			} else {
+				tightened := tighterUpper(*upper, b)
+				upper = &tightened
+			}
+		case GEPessimistic, Caret, Tilde:
+			upperVersion := constraint.version.IncrementPessimistic()
+			switch constraint.operator {
+			case Caret:
+				upperVersion = constraint.version.IncrementCaret()
+			case Tilde:
+				upperVersion = constraint.version.IncrementTilde()
+			}
+			lowerBound := bound{operator: GE, version: constraint.version}
+			upperBound := bound{operator: LT, version: upperVersion}
+			if lower == nil {
+				lower = &lowerBound
+			} else {
+				tightened := tighterLower(*lower, lowerBound)
+				lower = &tightened
+			}
+			if upper == nil {
+				upper = &upperBound
+			} else {
+				tightened := tighterUpper(*upper, upperBound)
+				upper = &tightened
+			}
+		}
+	}
+
+	if eq != nil {
+		if lower != nil && !eq.version.Satisfies(Constraint{operator: lower.operator, version: lower.version}) {
+			return nil, ContradictionErr{c1: *eq, c2: Constraint{operator: lower.operator, version: lower.version}}
+		}
+		if upper != nil && !eq.version.Satisfies(Constraint{operator: upper.operator, version: upper.version}) {
+			return nil, ContradictionErr{c1: *eq, c2: Constraint{operator: upper.operator, version: upper.version}}
+		}
+		for _, ne := range notEquals {
+			if eq.version.Equal(ne.version) {
+				return nil, ContradictionErr{c1: *eq, c2: ne}
+			}
+		}
+		return Constraints{*eq}, nil
+	}
+
+	if lower != nil && upper != nil {
+		impossible := lower.version.Greater(upper.version) ||
+			(lower.version.Equal(upper.version) && (lower.operator == GT || upper.operator == LT))
+		if impossible {
+			return nil, ContradictionErr{
+				c1: Constraint{operator: lower.operator, version: lower.version},
+				c2: Constraint{operator: upper.operator, version: upper.version},
+			}
+		}
+		if lower.version.Equal(upper.version) {
+			// Only `>= v, <= v` reaches here, which allows v alone.
+			collapsed := append(Constraints{{operator: EQ, version: lower.version}}, notEquals...)
+			return collapsed.Simplify()
+		}
+	}
+
+	var result Constraints
+	if lower != nil {
+		result = append(result, Constraint{operator: lower.operator, version: lower.version})
+	}
+	if upper != nil {
+		result = append(result, Constraint{operator: upper.operator, version: upper.version})
+	}
+
+	seen := make(map[string]bool, len(notEquals))
+	for _, ne := range notEquals {
+		if lower != nil && !ne.version.Satisfies(Constraint{operator: lower.operator, version: lower.version}) {
+			continue
+		}
+		if upper != nil && !ne.version.Satisfies(Constraint{operator: upper.operator, version: upper.version}) {
+			continue
+		}
+		key := ne.version.String()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, ne)
+	}
+
+	return result, nil
+}
+
+// intervalToConstraints converts a single canonical Interval back into the
+// Constraints AND-group it represents. The zero version as Lo and
+// HiInfinite as Hi are both implied for every Version, so the
+// corresponding bound is omitted rather than emitted redundantly.
+func intervalToConstraints(iv Interval) Constraints {
+	var result Constraints
+	if !iv.Lo.Equal(NewVersion(0, 0, 0)) {
+		result = append(result, Constraint{operator: GE, version: iv.Lo})
+	}
+	if !iv.HiInfinite {
+		result = append(result, Constraint{operator: LT, version: iv.Hi})
+	}
+	return result
+}
+
+// Simplify returns the ConstraintExpression equivalent to ce with every
+// unsatisfiable alternative dropped and the surviving ones canonicalized
+// into a minimal set of disjoint version ranges: overlapping or touching
+// alternatives are merged into one, the same way Constraints.Simplify
+// merges overlapping bounds within a single AND-group. If every
+// alternative is unsatisfiable, Simplify returns an error joining all of
+// their ContradictionErr values.
+func (ce ConstraintExpression) Simplify() (ConstraintExpression, error) {
+	if len(ce) == 0 {
+		return nil, nil
+	}
+
+	var (
+		union       VersionSet
+		satisfiable bool
+		err         error
+	)
+
+	for _, alternative := range ce {
+		if _, altErr := alternative.Simplify(); altErr != nil {
+			err = errors.Join(err, altErr)
+			continue
+		}
+		satisfiable = true
+		union = union.Union(NewVersionSet(alternative))
+	}
+
+	if !satisfiable {
+		return nil, fmt.Errorf("every alternative of the constraint expression is contradictory: %w", err)
+	}
+
+	result := make(ConstraintExpression, 0, len(union.intervals))
+	for _, iv := range union.intervals {
+		result = append(result, intervalToConstraints(iv))
+	}
+
+	return result, nil
+}
+
+// Bounds reduces c, via Simplify, to the tightest single bounded interval
+// it represents, returning its lower and upper Version and whether each
+// end is inclusive. ok is false if c is unsatisfiable or doesn't pin down
+// both a lower and an upper bound - an open-ended range like `>= 1.0.0` has
+// no upper Version to report, so there's no single bounded interval to
+// return. An `=` constraint reports lower and upper as the same Version,
+// both inclusive.
+func (c Constraints) Bounds() (lower, upper Version, lowerInclusive, upperInclusive, ok bool) {
+	simplified, err := c.Simplify()
+	if err != nil {
+		return Version{}, Version{}, false, false, false
+	}
+
+	var haveLower, haveUpper bool
+
+	for _, constraint := range simplified {
+		switch constraint.operator {
+		case EQ:
+			return constraint.version, constraint.version, true, true, true
+		case GE:
+			lower, lowerInclusive, haveLower = constraint.version, true, true
+		case GT:
+			lower, lowerInclusive, haveLower = constraint.version, false, true
+		case LE:
+			upper, upperInclusive, haveUpper = constraint.version, true, true
+		case LT:
+			upper, upperInclusive, haveUpper = constraint.version, false, true
+		}
+	}
+
+	if !haveLower || !haveUpper {
+		return Version{}, Version{}, false, false, false
+	}
+	return lower, upper, lowerInclusive, upperInclusive, true
+}
@@ -0,0 +1,191 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestConstraintsSimplify(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    verlib.Constraints
+		expected string
+	}{
+		{
+			name: "merges overlapping lower bounds",
+			input: verlib.Constraints{
+				verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0)),
+				verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 2, 0)),
+			},
+			expected: ">= 1.2.0",
+		},
+		{
+			name: "merges overlapping upper bounds",
+			input: verlib.Constraints{
+				verlib.NewConstraint(verlib.LT, verlib.NewVersion(3, 0, 0)),
+				verlib.NewConstraint(verlib.LE, verlib.NewVersion(2, 5, 0)),
+			},
+			expected: "<= 2.5.0",
+		},
+		{
+			name: "expands pessimistic bound",
+			input: verlib.Constraints{
+				verlib.NewConstraint(verlib.GEPessimistic, verlib.NewVersion(1, 2, 0)),
+			},
+			expected: ">= 1.2.0, < 1.3.0",
+		},
+		{
+			name: "drops ne already excluded by range",
+			input: verlib.Constraints{
+				verlib.NewConstraint(verlib.GE, verlib.NewVersion(2, 0, 0)),
+				verlib.NewConstraint(verlib.NE, verlib.NewVersion(1, 0, 0)),
+			},
+			expected: ">= 2.0.0",
+		},
+		{
+			name: "merges duplicate eq",
+			input: verlib.Constraints{
+				verlib.NewConstraint(verlib.EQ, verlib.NewVersion(1, 2, 3)),
+				verlib.NewConstraint(verlib.EQ, verlib.NewVersion(1, 2, 3)),
+			},
+			expected: "= 1.2.3",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			simplified, err := tc.input.Simplify()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if simplified.String() != tc.expected {
+				t.Errorf("got %q, expected %q", simplified.String(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestConstraintsSimplifyUnsatisfiable(t *testing.T) {
+	input := verlib.Constraints{
+		verlib.NewConstraint(verlib.GE, verlib.NewVersion(2, 0, 0)),
+		verlib.NewConstraint(verlib.LT, verlib.NewVersion(1, 5, 0)),
+	}
+
+	if _, err := input.Simplify(); err == nil {
+		t.Error("expected an error for an unsatisfiable constraint set")
+	}
+}
+
+func TestConstraintExpressionSimplify(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    verlib.ConstraintExpression
+		expected string
+	}{
+		{
+			name: "merges overlapping alternatives into one",
+			input: verlib.ConstraintExpression{
+				verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0))},
+				verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 2, 0))},
+			},
+			expected: ">= 1.0.0",
+		},
+		{
+			name: "keeps disjoint alternatives separate",
+			input: verlib.ConstraintExpression{
+				verlib.Constraints{verlib.NewConstraint(verlib.LT, verlib.NewVersion(1, 0, 0))},
+				verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(2, 0, 0))},
+			},
+			expected: "< 1.0.0 || >= 2.0.0",
+		},
+		{
+			name: "drops unsatisfiable alternatives",
+			input: verlib.ConstraintExpression{
+				verlib.Constraints{
+					verlib.NewConstraint(verlib.GE, verlib.NewVersion(2, 0, 0)),
+					verlib.NewConstraint(verlib.LT, verlib.NewVersion(1, 0, 0)),
+				},
+				verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(3, 0, 0))},
+			},
+			expected: ">= 3.0.0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			simplified, err := tc.input.Simplify()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if simplified.String() != tc.expected {
+				t.Errorf("got %q, expected %q", simplified.String(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestConstraintsBounds(t *testing.T) {
+	input := verlib.Constraints{
+		verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0)),
+		verlib.NewConstraint(verlib.LT, verlib.NewVersion(2, 0, 0)),
+	}
+
+	lower, upper, lowerInclusive, upperInclusive, ok := input.Bounds()
+	if !ok {
+		t.Fatal("expected >= 1.0.0, < 2.0.0 to report bounds")
+	}
+	if !lower.Equal(verlib.NewVersion(1, 0, 0)) || !lowerInclusive {
+		t.Errorf("got lower %s (inclusive=%v), expected 1.0.0 (inclusive=true)", lower.String(), lowerInclusive)
+	}
+	if !upper.Equal(verlib.NewVersion(2, 0, 0)) || upperInclusive {
+		t.Errorf("got upper %s (inclusive=%v), expected 2.0.0 (inclusive=false)", upper.String(), upperInclusive)
+	}
+}
+
+func TestConstraintsBoundsEquality(t *testing.T) {
+	input := verlib.Constraints{verlib.NewConstraint(verlib.EQ, verlib.NewVersion(1, 2, 3))}
+
+	lower, upper, lowerInclusive, upperInclusive, ok := input.Bounds()
+	if !ok {
+		t.Fatal("expected = 1.2.3 to report bounds")
+	}
+	if !lower.Equal(upper) || !lower.Equal(verlib.NewVersion(1, 2, 3)) {
+		t.Errorf("got lower %s, upper %s, expected both 1.2.3", lower.String(), upper.String())
+	}
+	if !lowerInclusive || !upperInclusive {
+		t.Error("expected both bounds to be inclusive for an = constraint")
+	}
+}
+
+func TestConstraintsBoundsOpenEnded(t *testing.T) {
+	input := verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0))}
+
+	if _, _, _, _, ok := input.Bounds(); ok {
+		t.Error("expected an open-ended range to not report a single bounded interval")
+	}
+}
+
+func TestConstraintsBoundsUnsatisfiable(t *testing.T) {
+	input := verlib.Constraints{
+		verlib.NewConstraint(verlib.GE, verlib.NewVersion(2, 0, 0)),
+		verlib.NewConstraint(verlib.LT, verlib.NewVersion(1, 0, 0)),
+	}
+
+	if _, _, _, _, ok := input.Bounds(); ok {
+		t.Error("expected an unsatisfiable set to not report bounds")
+	}
+}
+
+func TestConstraintExpressionSimplifyAllUnsatisfiable(t *testing.T) {
+	input := verlib.ConstraintExpression{
+		verlib.Constraints{
+			verlib.NewConstraint(verlib.GE, verlib.NewVersion(2, 0, 0)),
+			verlib.NewConstraint(verlib.LT, verlib.NewVersion(1, 0, 0)),
+		},
+	}
+
+	if _, err := input.Simplify(); err == nil {
+		t.Error("expected an error when every alternative is contradictory")
+	}
+}
@@ -0,0 +1,193 @@
+// Package solver implements a PubGrub-inspired dependency resolver on top of
+// the verlib constraint primitives. Given a catalog of packages, each
+// declaring its dependencies as a map of package name to verlib.Constraints,
+// it produces either a satisfying assignment of one Version per package, or
+// a ConflictError describing which dependencies could not be reconciled.
+//
+// This is a simplified variant of the full PubGrub algorithm: rather than
+// maintaining an incompatibility graph and backjumping across arbitrary
+// decision points, it greedily picks the newest version of each package that
+// satisfies every constraint asserted on it so far, and reports a conflict
+// (with a full derivation trace) the first time no such version exists. It
+// does not attempt to backtrack and try an older version of an
+// already-decided package, so it can report a conflict in some cases where a
+// different choice earlier in the resolution would have succeeded.
+package solver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+// Dependencies maps a dependency package name to the Constraints the
+// depending package places on it.
+type Dependencies map[string]verlib.Constraints
+
+// PackageVersion describes a single published version of a package and the
+// dependencies it declares.
+type PackageVersion struct {
+	Version      verlib.Version
+	Dependencies Dependencies
+}
+
+// Catalog describes, for every package name known to the solver, the list
+// of versions available to choose from.
+type Catalog map[string][]PackageVersion
+
+// Decision records that the solver chose a particular Version for a
+// package, and why it was asked to.
+type Decision struct {
+	Package string
+	Version verlib.Version
+	Cause   string
+}
+
+// Solution is the result of a successful Solve: one Decision per resolved
+// package, in the order decisions were made.
+type Solution struct {
+	Decisions []Decision
+}
+
+// Version returns the Version the Solution chose for the named package, and
+// whether that package was part of the solution at all.
+func (s Solution) Version(name string) (verlib.Version, bool) {
+	for _, d := range s.Decisions {
+		if d.Package == name {
+			return d.Version, true
+		}
+	}
+	return verlib.Version{}, false
+}
+
+// Derivation is one step in the explanation of why a package ended up
+// needing a particular Constraints value.
+type Derivation struct {
+	Package     string
+	Constraints verlib.Constraints
+	Cause       string
+}
+
+// ConflictError is returned by Solve when no version of some package can
+// satisfy every constraint that was derived for it. Trace lists, in order,
+// every constraint that was asserted on the offending package, so callers
+// can render a "because A depends on B >=1.0 and B >=1.0 requires C <2 ..."
+// style explanation.
+type ConflictError struct {
+	Package string
+	Trace   []Derivation
+}
+
+// Error implements the error interface, rendering the derivation trace that
+// led to the conflict.
+func (e *ConflictError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "no version of %q satisfies every derived constraint:\n", e.Package)
+	for _, d := range e.Trace {
+		fmt.Fprintf(&b, "  because %s, %s must satisfy %s\n", d.Cause, e.Package, d.Constraints.String())
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// solverState tracks the constraints derived so far for every package, the
+// decisions made, and the derivation trace behind each package's
+// constraints.
+type solverState struct {
+	catalog     Catalog
+	constraints map[string]verlib.Constraints
+	trace       map[string][]Derivation
+	decisions   map[string]verlib.Version
+	order       []string
+}
+
+// addConstraint records that constraints must additionally hold for name,
+// because of cause, and returns true if this is the first constraint ever
+// derived for name (meaning the caller should enqueue it for resolution).
+func (s *solverState) addConstraint(name string, constraints verlib.Constraints, cause string) bool {
+	_, known := s.constraints[name]
+	s.constraints[name] = append(s.constraints[name], constraints...)
+	s.trace[name] = append(s.trace[name], Derivation{Package: name, Constraints: constraints, Cause: cause})
+	return !known
+}
+
+// Solve resolves root's dependencies against catalog, returning a Solution
+// that satisfies every declared Constraints, or a *ConflictError describing
+// the first unsatisfiable package encountered.
+func Solve(catalog Catalog, root Dependencies) (*Solution, error) {
+	state := &solverState{
+		catalog:     catalog,
+		constraints: make(map[string]verlib.Constraints),
+		trace:       make(map[string][]Derivation),
+		decisions:   make(map[string]verlib.Version),
+	}
+
+	var queue []string
+	for name, constraints := range root {
+		state.addConstraint(name, constraints, "root requires "+name)
+		queue = append(queue, name)
+	}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		newDeps, err := state.resolve(name)
+		if err != nil {
+			return nil, err
+		}
+		queue = append(queue, newDeps...)
+	}
+
+	solution := &Solution{}
+	for _, name := range state.order {
+		solution.Decisions = append(solution.Decisions, Decision{
+			Package: name,
+			Version: state.decisions[name],
+			Cause:   state.trace[name][0].Cause,
+		})
+	}
+
+	return solution, nil
+}
+
+// resolve ensures name has a Version decided that satisfies every
+// constraint derived for it so far. If name was already decided, the
+// existing decision is revalidated against any newly added constraints. It
+// returns the names of any new dependencies that should be enqueued for
+// resolution.
+func (s *solverState) resolve(name string) ([]string, error) {
+	merged := s.constraints[name]
+
+	if decided, ok := s.decisions[name]; ok {
+		if !decided.SatisfiesAll(merged) {
+			return nil, &ConflictError{Package: name, Trace: s.trace[name]}
+		}
+		return nil, nil
+	}
+
+	candidates := append([]PackageVersion(nil), s.catalog[name]...)
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[j].Version.Less(candidates[i].Version)
+	})
+
+	for _, candidate := range candidates {
+		if !candidate.Version.SatisfiesAll(merged) {
+			continue
+		}
+
+		s.decisions[name] = candidate.Version
+		s.order = append(s.order, name)
+
+		var newDeps []string
+		for depName, depConstraints := range candidate.Dependencies {
+			cause := fmt.Sprintf("%s@%s depends on %s", name, candidate.Version.String(), depName)
+			s.addConstraint(depName, depConstraints, cause)
+			newDeps = append(newDeps, depName)
+		}
+		return newDeps, nil
+	}
+
+	return nil, &ConflictError{Package: name, Trace: s.trace[name]}
+}
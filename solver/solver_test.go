@@ -0,0 +1,70 @@
+package solver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+	"github.com/DwaineSaunderson/go-verlib/solver"
+)
+
+func TestSolveSimpleGraph(t *testing.T) {
+	catalog := solver.Catalog{
+		"b": {
+			{Version: verlib.NewVersion(1, 0, 0)},
+			{Version: verlib.NewVersion(2, 0, 0)},
+		},
+	}
+
+	root := solver.Dependencies{
+		"b": verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 5, 0))},
+	}
+
+	solution, err := solver.Solve(catalog, root)
+	if err != nil {
+		t.Fatalf("unexpected conflict: %v", err)
+	}
+
+	got, ok := solution.Version("b")
+	if !ok {
+		t.Fatalf("expected a decision for b")
+	}
+	if !got.Equal(verlib.NewVersion(2, 0, 0)) {
+		t.Errorf("expected b to resolve to 2.0.0, got %s", got.String())
+	}
+}
+
+func TestSolveConflict(t *testing.T) {
+	catalog := solver.Catalog{
+		"b": {
+			{Version: verlib.NewVersion(1, 0, 0)},
+		},
+		"c": {
+			{
+				Version:      verlib.NewVersion(1, 0, 0),
+				Dependencies: solver.Dependencies{"b": verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(2, 0, 0))}},
+			},
+		},
+	}
+
+	root := solver.Dependencies{
+		"b": verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0))},
+		"c": verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0))},
+	}
+
+	_, err := solver.Solve(catalog, root)
+	if err == nil {
+		t.Fatal("expected a conflict, got nil")
+	}
+
+	conflict, ok := err.(*solver.ConflictError)
+	if !ok {
+		t.Fatalf("expected *solver.ConflictError, got %T", err)
+	}
+	if conflict.Package != "b" {
+		t.Errorf("expected conflict on package b, got %s", conflict.Package)
+	}
+	if !strings.Contains(conflict.Error(), "c@1.0.0 depends on b") {
+		t.Errorf("expected trace to mention c's dependency on b, got: %s", conflict.Error())
+	}
+}
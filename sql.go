@@ -0,0 +1,106 @@
+package verlib
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value implements driver.Valuer, storing v as its canonical (non-strict)
+// String() form so it round-trips through a text/varchar database column.
+func (v Version) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or []byte column value with
+// ParseVersion. A NULL column (src == nil) leaves v as the zero Version,
+// mirroring how sql.NullString treats NULL as its zero value rather than
+// an error.
+func (v *Version) Scan(src interface{}) error {
+	if src == nil {
+		*v = Version{}
+		return nil
+	}
+
+	text, err := scanText(src)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := ParseVersion(text)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, storing c as its canonical (non-strict)
+// String() form so it round-trips through a text/varchar database column.
+func (c Constraint) Value() (driver.Value, error) {
+	return c.String(), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or []byte column value with
+// ParseConstraint. A NULL column (src == nil) leaves c as the zero
+// Constraint, mirroring how sql.NullString treats NULL as its zero value
+// rather than an error.
+func (c *Constraint) Scan(src interface{}) error {
+	if src == nil {
+		*c = Constraint{}
+		return nil
+	}
+
+	text, err := scanText(src)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := ParseConstraint(text)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, storing c as its canonical, comma-joined
+// StrictString() form so it round-trips through a text/varchar database
+// column.
+func (c Constraints) Value() (driver.Value, error) {
+	return c.StrictString()
+}
+
+// Scan implements sql.Scanner, parsing a string or []byte column value with
+// ParseConstraintSet. A NULL column (src == nil) leaves c nil, mirroring
+// how sql.NullString treats NULL as its zero value rather than an error.
+func (c *Constraints) Scan(src interface{}) error {
+	if src == nil {
+		*c = nil
+		return nil
+	}
+
+	text, err := scanText(src)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := ParseConstraintSet(text)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// scanText coerces a sql.Scanner source value into a string, the only two
+// shapes database/sql drivers hand a Scanner for a text/varchar column.
+func scanText(src interface{}) (string, error) {
+	switch t := src.(type) {
+	case string:
+		return t, nil
+	case []byte:
+		return string(t), nil
+	default:
+		return "", fmt.Errorf("verlib: cannot scan %T into a version type", src)
+	}
+}
@@ -0,0 +1,112 @@
+package verlib_test
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestVersionValueScanRoundTrip(t *testing.T) {
+	original := verlib.NewPreReleaseVersion(1, 2, 3, "beta.1")
+
+	value, err := original.Value()
+	if err != nil {
+		t.Fatalf("unexpected error from Value: %v", err)
+	}
+
+	var scanned verlib.Version
+	if err := scanned.Scan(value); err != nil {
+		t.Fatalf("unexpected error from Scan: %v", err)
+	}
+	if !scanned.Equal(original) {
+		t.Errorf("got %s, expected %s", scanned.String(), original.String())
+	}
+}
+
+func TestVersionScanBytes(t *testing.T) {
+	var scanned verlib.Version
+	if err := scanned.Scan([]byte("1.2.3")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if scanned.String() != "1.2.3" {
+		t.Errorf("got %s, expected 1.2.3", scanned.String())
+	}
+}
+
+func TestVersionScanNull(t *testing.T) {
+	scanned := verlib.NewVersion(1, 2, 3)
+	if err := scanned.Scan(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !scanned.Equal(verlib.Version{}) {
+		t.Errorf("expected scanning NULL to reset to the zero Version, got %s", scanned.String())
+	}
+}
+
+func TestConstraintValueScanRoundTrip(t *testing.T) {
+	original := verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 2, 3))
+
+	value, err := original.Value()
+	if err != nil {
+		t.Fatalf("unexpected error from Value: %v", err)
+	}
+
+	var scanned verlib.Constraint
+	if err := scanned.Scan(value); err != nil {
+		t.Fatalf("unexpected error from Scan: %v", err)
+	}
+	if scanned.String() != original.String() {
+		t.Errorf("got %q, expected %q", scanned.String(), original.String())
+	}
+}
+
+func TestConstraintsValueScanRoundTrip(t *testing.T) {
+	original, err := verlib.ParseConstraintSet(">= 1.0.0, < 2.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	value, err := original.Value()
+	if err != nil {
+		t.Fatalf("unexpected error from Value: %v", err)
+	}
+
+	var scanned verlib.Constraints
+	if err := scanned.Scan(value); err != nil {
+		t.Fatalf("unexpected error from Scan: %v", err)
+	}
+	if scanned.String() != original.String() {
+		t.Errorf("got %q, expected %q", scanned.String(), original.String())
+	}
+}
+
+func TestConstraintsScanNull(t *testing.T) {
+	scanned := verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0))}
+	if err := scanned.Scan(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if scanned != nil {
+		t.Errorf("expected scanning NULL to reset Constraints to nil, got %v", scanned)
+	}
+}
+
+func TestVersionScanInvalidType(t *testing.T) {
+	var scanned verlib.Version
+	if err := scanned.Scan(42); err == nil {
+		t.Error("expected an error scanning an int into Version")
+	}
+}
+
+func TestVersionScanEmptyString(t *testing.T) {
+	var scanned verlib.Version
+	if err := scanned.Scan(""); err == nil {
+		t.Error("expected an error scanning an empty (non-NULL) string into Version")
+	}
+}
+
+var (
+	_ driver.Valuer = verlib.Version{}
+	_ driver.Valuer = verlib.Constraint{}
+	_ driver.Valuer = verlib.Constraints{}
+)
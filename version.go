@@ -8,11 +8,16 @@ import (
 // Version represents a version number compliant with Semantic Versioning (SemVer).
 // It encapsulates the major, minor and patch version numbers, along with pre-release and build metadata information.
 type Version struct {
-	major         uint64  // major component of the version
-	minor         *uint64 // minor component of the version. Optional.
-	patch         *uint64 // patch component of the version. Optional.
-	preRelease    string  // preRelease is the pre-release label of the version. Optional.
-	buildMetadata string  // Build metadata. Optional
+	major         uint64   // major component of the version
+	minor         *uint64  // minor component of the version. Optional.
+	patch         *uint64  // patch component of the version. Optional.
+	preRelease    string   // preRelease is the pre-release label of the version. Optional.
+	buildMetadata string   // Build metadata. Optional
+	ordering      Ordering // ordering overrides DefaultOrdering for Compare. Optional.
+	Extra         []uint64 // Extra holds any numeric components beyond patch, as produced by ParseVersionLenient. Optional.
+	original      string   // original is the exact string ParseVersionLenient or ParseTolerant was given, if any. Optional.
+	Kind          Kind     // Kind records which version scheme v was parsed under. Defaults to KindSemVer.
+	hadVPrefix    bool     // hadVPrefix records whether WithVPrefix(true) was applied; parsing never sets it. String then prepends "v".
 }
 
 // NewVersion creates and returns a Version instance with the given major, minor, and patch numbers.
@@ -84,6 +89,20 @@ func (v Version) BuildMetadata() string {
 	return v.buildMetadata
 }
 
+// Original returns the exact string ParseVersionLenient parsed to produce
+// v, allowing round-tripping of non-canonical input forms (a leading "v",
+// four-or-more numeric components, etc.) for display. If v was not built
+// via ParseVersionLenient, Original returns an empty string.
+func (v Version) Original() string {
+	return v.original
+}
+
+// OriginalString is an alias for Original, provided under the name used by
+// some downstream tooling for the exact string a Version was parsed from.
+func (v Version) OriginalString() string {
+	return v.Original()
+}
+
 // SetBuildMetadata creates a copy of the Version struct, sets the buildMetadata field to the given string,
 // and returns the updated copy. This method can be used to change the build metadata of a version without
 // modifying the original Version struct.
@@ -100,9 +119,31 @@ func (v Version) SetBuildMetadata(buildMetadata string) Version {
 	return ver
 }
 
+// WithVPrefix creates a copy of the Version with hadVPrefix set to include,
+// controlling whether String prepends a leading "v" (the form used by Go
+// module tags and GitHub releases). Parsing never sets this on its own -
+// ParseVersionLenient and ParseTolerant still normalize to a canonical,
+// prefix-free String and preserve the exact input on Original() instead -
+// so the prefix only ever appears when a caller opts in here. It does not
+// affect comparison or ordering, which are unaffected by the prefix.
+func (v Version) WithVPrefix(include bool) Version {
+	ver := v.clone()
+	ver.hadVPrefix = include
+	return ver
+}
+
+// HadVPrefix reports whether WithVPrefix(true) was applied to v.
+func (v Version) HadVPrefix() bool {
+	return v.hadVPrefix
+}
+
 // String returns a string representation of the Version, adhering to the SemVer 2.0.0 format.
 func (v Version) String() string {
-	versionStr := strconv.FormatUint(v.major, 10)
+	versionStr := ""
+	if v.hadVPrefix {
+		versionStr = "v"
+	}
+	versionStr += strconv.FormatUint(v.major, 10)
 
 	if v.minor != nil {
 		versionStr += "." + strconv.FormatUint(*v.minor, 10)
@@ -179,6 +220,9 @@ func (v Version) Less(other Version) bool {
 	if v.Patch() != other.Patch() {
 		return v.Patch() < other.Patch()
 	}
+	if c := compareExtra(v.Extra, other.Extra); c != 0 {
+		return c < 0
+	}
 
 	if v.preRelease == "" && other.preRelease != "" {
 		return false
@@ -186,7 +230,15 @@ func (v Version) Less(other Version) bool {
 	if v.preRelease != "" && other.preRelease == "" {
 		return true
 	}
-	return v.preRelease < other.preRelease
+
+	// A Go module pseudo-version represents a commit after every tagged
+	// prerelease of the same base version, so it sorts above them even
+	// though its pre-release label compares lower as a plain string.
+	if vPseudo, otherPseudo := v.IsPseudo(), other.IsPseudo(); vPseudo != otherPseudo {
+		return otherPseudo
+	}
+
+	return comparePreReleaseIdentifiers(v.preRelease, other.preRelease) < 0
 }
 
 // Greater checks if this Version is greater than the other Version. It makes use of the Less method defined for the
@@ -313,6 +365,19 @@ func (v Version) IncrementPessimistic() Version {
 	newVersion.preRelease = ""
 	newVersion.buildMetadata = ""
 
+	if newVersion.Kind == KindDotted && len(newVersion.Extra) > 0 {
+		// The rightmost segment (the last of Extra) is the one allowed to
+		// float; the segment before it is what gets bumped, same as patch
+		// bumping minor in the three-component case below.
+		if len(newVersion.Extra) == 1 {
+			*newVersion.patch++
+		} else {
+			newVersion.Extra[len(newVersion.Extra)-2]++
+		}
+		newVersion.Extra[len(newVersion.Extra)-1] = 0
+		return newVersion
+	}
+
 	if newVersion.patch != nil {
 		*newVersion.patch = 0
 		*newVersion.minor++
@@ -325,6 +390,36 @@ func (v Version) IncrementPessimistic() Version {
 	return newVersion
 }
 
+// IncrementCaret computes the exclusive upper bound of a caret range (`^`)
+// anchored at v: the first nonzero component, scanning major, minor, then
+// patch, is the one that may not change; every component to its left is
+// held fixed and every component to its right is reset to zero. This
+// matches npm's interpretation of `^`, e.g. `^1.2.3` allows up to (but not
+// including) "2.0.0", while `^0.2.3` allows up to "0.3.0" and `^0.0.3`
+// allows up to "0.0.4".
+func (v Version) IncrementCaret() Version {
+	switch {
+	case v.major != 0:
+		return v.IncrementMajor()
+	case v.Minor() != 0:
+		return v.IncrementMinor()
+	default:
+		return v.IncrementPatch()
+	}
+}
+
+// IncrementTilde computes the exclusive upper bound of a tilde range (`~`)
+// anchored at v: patch-level changes are allowed when a minor version is
+// specified, otherwise minor-level changes are allowed. For example,
+// `~1.2.3` and `~1.2` both allow up to (but not including) "1.3.0", while
+// `~1` allows up to "2.0.0".
+func (v Version) IncrementTilde() Version {
+	if v.minor == nil {
+		return v.IncrementMajor()
+	}
+	return v.IncrementMinor()
+}
+
 // clone returns a deep copy of the Version. This is used in the Increment method to avoid mutating the original Version.
 func (v Version) clone() Version {
 	var minor, patch *uint64
@@ -344,5 +439,32 @@ func (v Version) clone() Version {
 		patch:         patch,
 		preRelease:    v.preRelease,
 		buildMetadata: v.buildMetadata,
+		ordering:      v.ordering,
+		Extra:         append([]uint64(nil), v.Extra...),
+		original:      v.original,
+		Kind:          v.Kind,
+		hadVPrefix:    v.hadVPrefix,
+	}
+}
+
+// compareExtra compares two Extra component slices pairwise, treating a
+// shorter slice as zero-padded to the length of the longer one. It returns
+// -1, 0, or 1 the same way Compare does.
+func compareExtra(a, b []uint64) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var av, bv uint64
+		if i < len(a) {
+			av = a[i]
+		}
+		if i < len(b) {
+			bv = b[i]
+		}
+		switch {
+		case av < bv:
+			return -1
+		case av > bv:
+			return 1
+		}
 	}
+	return 0
 }
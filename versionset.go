@@ -0,0 +1,230 @@
+package verlib
+
+import "sort"
+
+// Interval is a half-open range of versions [Lo, Hi), or [Lo, +Inf) when
+// HiInfinite is true.
+type Interval struct {
+	Lo         Version
+	Hi         Version
+	HiInfinite bool
+}
+
+// VersionSet represents the set of versions matching an arbitrary boolean
+// combination of Constraints, stored internally as a canonicalized
+// (sorted, non-overlapping, merged) list of half-open Interval values over
+// the total ordering Version.Less already implements.
+type VersionSet struct {
+	intervals []Interval
+}
+
+// universalInterval is the unconstrained [0, +Inf) interval every
+// NewVersionSet computation starts from.
+func universalInterval() Interval {
+	return Interval{Lo: NewVersion(0, 0, 0), HiInfinite: true}
+}
+
+// NewVersionSet converts Constraints (an AND-group) into the VersionSet of
+// versions satisfying all of them: each Constraint becomes an interval (or
+// pair of intervals, for `!=`), and the AND-group's set is the intersection
+// of all of them.
+//
+//	=v  -> [v, v+ε)       where v+ε is v.Increment()
+//	!=v -> [0, v) ∪ [v+ε, +Inf)
+//	>v  -> [v+ε, +Inf)
+//	>=v -> [v, +Inf)
+//	<v  -> [0, v)
+//	<=v -> [0, v+ε)
+//	~>v -> [v, v.IncrementPessimistic())
+//	^v  -> [v, v.IncrementCaret())
+//	~v  -> [v, v.IncrementTilde())
+func NewVersionSet(c Constraints) VersionSet {
+	result := VersionSet{intervals: []Interval{universalInterval()}}
+
+	for _, constraint := range c {
+		result = result.Intersect(constraintToVersionSet(constraint))
+	}
+
+	return result
+}
+
+// constraintToVersionSet converts a single Constraint into the VersionSet
+// it allows on its own.
+func constraintToVersionSet(c Constraint) VersionSet {
+	switch c.operator {
+	case EQ:
+		return VersionSet{intervals: []Interval{{Lo: c.version, Hi: c.version.Increment()}}}
+	case NE:
+		return VersionSet{intervals: []Interval{
+			{Lo: NewVersion(0, 0, 0), Hi: c.version},
+			{Lo: c.version.Increment(), HiInfinite: true},
+		}}.normalize()
+	case GT:
+		return VersionSet{intervals: []Interval{{Lo: c.version.Increment(), HiInfinite: true}}}
+	case GE:
+		return VersionSet{intervals: []Interval{{Lo: c.version, HiInfinite: true}}}
+	case LT:
+		return VersionSet{intervals: []Interval{{Lo: NewVersion(0, 0, 0), Hi: c.version}}}
+	case LE:
+		return VersionSet{intervals: []Interval{{Lo: NewVersion(0, 0, 0), Hi: c.version.Increment()}}}
+	case GEPessimistic:
+		return VersionSet{intervals: []Interval{{Lo: c.version, Hi: c.version.IncrementPessimistic()}}}
+	case Caret:
+		return VersionSet{intervals: []Interval{{Lo: c.version, Hi: c.version.IncrementCaret()}}}
+	case Tilde:
+		return VersionSet{intervals: []Interval{{Lo: c.version, Hi: c.version.IncrementTilde()}}}
+	default:
+		return VersionSet{}
+	}
+}
+
+// hiLess reports whether the hi bound of a sorts before the hi bound of b,
+// treating HiInfinite as greater than any finite bound.
+func hiLess(a, b Interval) bool {
+	if a.HiInfinite {
+		return false
+	}
+	if b.HiInfinite {
+		return true
+	}
+	return a.Hi.Less(b.Hi)
+}
+
+// normalize sorts vs.intervals by Lo and merges any that touch or overlap.
+func (vs VersionSet) normalize() VersionSet {
+	intervals := append([]Interval(nil), vs.intervals...)
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].Lo.Less(intervals[j].Lo)
+	})
+
+	var merged []Interval
+	for _, iv := range intervals {
+		if len(merged) == 0 {
+			merged = append(merged, iv)
+			continue
+		}
+
+		last := &merged[len(merged)-1]
+		if last.HiInfinite || !last.Hi.Less(iv.Lo) {
+			if hiLess(*last, iv) {
+				last.Hi, last.HiInfinite = iv.Hi, iv.HiInfinite
+			}
+			continue
+		}
+
+		merged = append(merged, iv)
+	}
+
+	return VersionSet{intervals: merged}
+}
+
+// IsEmpty reports whether vs matches no versions at all.
+func (vs VersionSet) IsEmpty() bool {
+	return len(vs.intervals) == 0
+}
+
+// Contains reports whether v falls within any interval of vs.
+func (vs VersionSet) Contains(v Version) bool {
+	for _, iv := range vs.intervals {
+		if v.Less(iv.Lo) {
+			continue
+		}
+		if iv.HiInfinite || v.Less(iv.Hi) {
+			return true
+		}
+	}
+	return false
+}
+
+// Intersect returns the VersionSet matching versions present in both vs and
+// other, by intersecting every interval of vs with every interval of other
+// and normalizing the result.
+func (vs VersionSet) Intersect(other VersionSet) VersionSet {
+	var result []Interval
+
+	for _, a := range vs.intervals {
+		for _, b := range other.intervals {
+			lo := a.Lo
+			if b.Lo.Greater(lo) {
+				lo = b.Lo
+			}
+
+			var hi Version
+			hiInfinite := a.HiInfinite && b.HiInfinite
+			switch {
+			case a.HiInfinite:
+				hi = b.Hi
+			case b.HiInfinite:
+				hi = a.Hi
+			case a.Hi.Less(b.Hi):
+				hi = a.Hi
+			default:
+				hi = b.Hi
+			}
+
+			if !hiInfinite && !lo.Less(hi) {
+				continue
+			}
+			result = append(result, Interval{Lo: lo, Hi: hi, HiInfinite: hiInfinite})
+		}
+	}
+
+	return VersionSet{intervals: result}.normalize()
+}
+
+// Union returns the VersionSet matching versions present in either vs or
+// other.
+func (vs VersionSet) Union(other VersionSet) VersionSet {
+	combined := append(append([]Interval(nil), vs.intervals...), other.intervals...)
+	return VersionSet{intervals: combined}.normalize()
+}
+
+// Complement returns the VersionSet of every version not matched by vs,
+// relative to the universal [0, +Inf) range.
+func (vs VersionSet) Complement() VersionSet {
+	normalized := vs.normalize()
+
+	if len(normalized.intervals) == 0 {
+		return VersionSet{intervals: []Interval{universalInterval()}}
+	}
+
+	var result []Interval
+	cursor := NewVersion(0, 0, 0)
+
+	for _, iv := range normalized.intervals {
+		if cursor.Less(iv.Lo) {
+			result = append(result, Interval{Lo: cursor, Hi: iv.Lo})
+		}
+		if iv.HiInfinite {
+			return VersionSet{intervals: result}
+		}
+		cursor = iv.Hi
+	}
+
+	result = append(result, Interval{Lo: cursor, HiInfinite: true})
+	return VersionSet{intervals: result}
+}
+
+// Subtract returns the VersionSet matching versions in vs that are not also
+// in other.
+func (vs VersionSet) Subtract(other VersionSet) VersionSet {
+	return vs.Intersect(other.Complement())
+}
+
+// Newest returns the highest Version in candidates that vs contains.
+func (vs VersionSet) Newest(candidates []Version) (Version, bool) {
+	var best Version
+	found := false
+
+	for _, candidate := range candidates {
+		if !vs.Contains(candidate) {
+			continue
+		}
+		if !found || best.Less(candidate) {
+			best = candidate
+			found = true
+		}
+	}
+
+	return best, found
+}
@@ -0,0 +1,82 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestVersionSetContains(t *testing.T) {
+	vs := verlib.NewVersionSet(verlib.Constraints{
+		verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0)),
+		verlib.NewConstraint(verlib.LT, verlib.NewVersion(2, 0, 0)),
+	})
+
+	if !vs.Contains(verlib.NewVersion(1, 5, 0)) {
+		t.Error("expected [1.0.0, 2.0.0) to contain 1.5.0")
+	}
+	if vs.Contains(verlib.NewVersion(2, 0, 0)) {
+		t.Error("expected [1.0.0, 2.0.0) to exclude 2.0.0")
+	}
+	if vs.Contains(verlib.NewVersion(0, 9, 0)) {
+		t.Error("expected [1.0.0, 2.0.0) to exclude 0.9.0")
+	}
+}
+
+func TestVersionSetIntersectUnionSubtract(t *testing.T) {
+	a := verlib.NewVersionSet(verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0))})
+	b := verlib.NewVersionSet(verlib.Constraints{verlib.NewConstraint(verlib.LT, verlib.NewVersion(2, 0, 0))})
+
+	intersected := a.Intersect(b)
+	if !intersected.Contains(verlib.NewVersion(1, 5, 0)) || intersected.Contains(verlib.NewVersion(2, 0, 0)) {
+		t.Error("expected intersection to equal [1.0.0, 2.0.0)")
+	}
+
+	union := a.Union(verlib.NewVersionSet(verlib.Constraints{verlib.NewConstraint(verlib.LT, verlib.NewVersion(0, 5, 0))}))
+	if !union.Contains(verlib.NewVersion(0, 1, 0)) || !union.Contains(verlib.NewVersion(5, 0, 0)) {
+		t.Error("expected union to cover both ranges")
+	}
+
+	subtracted := a.Subtract(verlib.NewVersionSet(verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(3, 0, 0))}))
+	if !subtracted.Contains(verlib.NewVersion(1, 0, 0)) || subtracted.Contains(verlib.NewVersion(3, 0, 0)) {
+		t.Error("expected subtraction to remove everything >= 3.0.0")
+	}
+}
+
+func TestVersionSetNotEqual(t *testing.T) {
+	vs := verlib.NewVersionSet(verlib.Constraints{verlib.NewConstraint(verlib.NE, verlib.NewVersion(1, 2, 3))})
+
+	if vs.Contains(verlib.NewVersion(1, 2, 3)) {
+		t.Error("expected != 1.2.3 to exclude 1.2.3")
+	}
+	if !vs.Contains(verlib.NewVersion(1, 2, 4)) || !vs.Contains(verlib.NewVersion(0, 0, 1)) {
+		t.Error("expected != 1.2.3 to include everything else")
+	}
+}
+
+func TestVersionSetNewest(t *testing.T) {
+	vs := verlib.NewVersionSet(verlib.Constraints{verlib.NewConstraint(verlib.LT, verlib.NewVersion(2, 0, 0))})
+
+	candidates := []verlib.Version{
+		verlib.NewVersion(1, 0, 0),
+		verlib.NewVersion(1, 9, 0),
+		verlib.NewVersion(2, 0, 0),
+	}
+
+	best, ok := vs.Newest(candidates)
+	if !ok || !best.Equal(verlib.NewVersion(1, 9, 0)) {
+		t.Errorf("expected newest matching candidate to be 1.9.0, got %s (ok=%t)", best.String(), ok)
+	}
+}
+
+func TestVersionSetComplement(t *testing.T) {
+	vs := verlib.NewVersionSet(verlib.Constraints{verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0))})
+	complement := vs.Complement()
+
+	if !complement.Contains(verlib.NewVersion(0, 5, 0)) {
+		t.Error("expected complement of >= 1.0.0 to include 0.5.0")
+	}
+	if complement.Contains(verlib.NewVersion(1, 0, 0)) {
+		t.Error("expected complement of >= 1.0.0 to exclude 1.0.0")
+	}
+}
@@ -0,0 +1,46 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+func TestParseVersionLenientPreservesOriginalVPrefix(t *testing.T) {
+	v := verlib.MustParseVersionLenient("v1.2.3")
+
+	if v.HadVPrefix() {
+		t.Error("expected parsing to leave hadVPrefix unset; String stays canonical unless WithVPrefix is used")
+	}
+	if v.String() != "1.2.3" {
+		t.Errorf("got %q, expected canonical %q", v.String(), "1.2.3")
+	}
+	if v.OriginalString() != "v1.2.3" {
+		t.Errorf("got %q, expected %q", v.OriginalString(), "v1.2.3")
+	}
+}
+
+func TestParseVersionLenientWithoutVPrefix(t *testing.T) {
+	v := verlib.MustParseVersionLenient("1.2.3")
+
+	if v.HadVPrefix() {
+		t.Error("expected no leading 'v' to be recorded")
+	}
+	if v.String() != "1.2.3" {
+		t.Errorf("got %q, expected %q", v.String(), "1.2.3")
+	}
+}
+
+func TestWithVPrefix(t *testing.T) {
+	v := verlib.NewVersion(1, 2, 3).WithVPrefix(true)
+
+	if v.String() != "v1.2.3" {
+		t.Errorf("got %q, expected %q", v.String(), "v1.2.3")
+	}
+	if !v.Equal(verlib.NewVersion(1, 2, 3)) {
+		t.Error("expected WithVPrefix to leave comparison unaffected")
+	}
+	if !v.WithVPrefix(true).Greater(verlib.NewVersion(1, 2, 2)) {
+		t.Error("expected ordering to still work normally with a prefix set")
+	}
+}
@@ -0,0 +1,54 @@
+package verlib_test
+
+import (
+	"testing"
+
+	"github.com/DwaineSaunderson/go-verlib"
+)
+
+// fakeUnmarshal mimics the `unmarshal func(interface{}) error` callback a
+// gopkg.in/yaml.v2 decoder passes to yaml.Unmarshaler, copying text into
+// whatever string pointer it's given.
+func fakeUnmarshal(text string) func(interface{}) error {
+	return func(out interface{}) error {
+		*(out.(*string)) = text
+		return nil
+	}
+}
+
+func TestVersionYAMLRoundTrip(t *testing.T) {
+	original := verlib.NewPreReleaseVersion(1, 2, 3, "beta.1")
+
+	value, err := original.MarshalYAML()
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+
+	var roundTripped verlib.Version
+	if err := roundTripped.UnmarshalYAML(fakeUnmarshal(value.(string))); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if !roundTripped.Equal(original) {
+		t.Errorf("got %s, expected %s", roundTripped.String(), original.String())
+	}
+}
+
+func TestConstraintsYAMLRoundTrip(t *testing.T) {
+	original := verlib.Constraints{
+		verlib.NewConstraint(verlib.GE, verlib.NewVersion(1, 0, 0)),
+		verlib.NewConstraint(verlib.LT, verlib.NewVersion(2, 0, 0)),
+	}
+
+	value, err := original.MarshalYAML()
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+
+	var roundTripped verlib.Constraints
+	if err := roundTripped.UnmarshalYAML(fakeUnmarshal(value.(string))); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if roundTripped.String() != original.String() {
+		t.Errorf("got %q, expected %q", roundTripped.String(), original.String())
+	}
+}